@@ -0,0 +1,213 @@
+package authnet
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// amountScale is the number of units in 1.00. Amounts are stored in ten thousandths so that intermediate results, such
+// as a tax rate applied to a price, keep fractions of a cent until they are rounded.
+const amountScale = 10000
+
+// Amount is an exact decimal amount of money. Unlike float64 it represents cents exactly and always marshals to a fixed
+// point string with two decimal places, such as 1000000.00, instead of 1e+06.
+//
+// The zero value is 0.00.
+type Amount struct {
+	units int64
+}
+
+// AmountFromCents creates an Amount from a number of cents, so 1999 is 19.99.
+func AmountFromCents(cents int64) Amount {
+	return Amount{units: cents * (amountScale / 100)}
+}
+
+// AmountFromInt creates an Amount from a whole number, so 20 is 20.00.
+func AmountFromInt(value int64) Amount {
+	return Amount{units: value * amountScale}
+}
+
+// ParseAmount parses a decimal string such as 19.99, -5 or 0.0125. At most four significant decimal places are
+// accepted.
+func ParseAmount(s string) (Amount, error) {
+	value := strings.TrimSpace(s)
+	negative := false
+	if strings.HasPrefix(value, "-") || strings.HasPrefix(value, "+") {
+		negative = value[0] == '-'
+		value = value[1:]
+	}
+	whole, fraction, _ := strings.Cut(value, ".")
+	if len(whole) == 0 && len(fraction) == 0 || len(whole) > 14 || !isDigits(whole) || !isDigits(fraction) {
+		return Amount{}, fmt.Errorf("invalid amount %q", s)
+	}
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) > 4 {
+		return Amount{}, fmt.Errorf("amount %q has more than 4 decimal places", s)
+	}
+	fraction += strings.Repeat("0", 4-len(fraction))
+	var units int64
+	for _, digit := range whole + fraction {
+		units = units*10 + int64(digit-'0')
+	}
+	if negative {
+		units = -units
+	}
+	return Amount{units: units}, nil
+}
+
+// MustParseAmount is like ParseAmount but panics if s is not a valid amount. It is intended for constants.
+func MustParseAmount(s string) Amount {
+	amount, err := ParseAmount(s)
+	if err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func (a Amount) Add(b Amount) Amount {
+	return Amount{units: a.units + b.units}
+}
+
+func (a Amount) Sub(b Amount) Amount {
+	return Amount{units: a.units - b.units}
+}
+
+// Mul multiplies the amount by a whole quantity.
+func (a Amount) Mul(quantity int64) Amount {
+	return Amount{units: a.units * quantity}
+}
+
+func (a Amount) Neg() Amount {
+	return Amount{units: -a.units}
+}
+
+// Cmp returns -1, 0 or +1 when a is less than, equal to or greater than b.
+func (a Amount) Cmp(b Amount) int {
+	switch {
+	case a.units < b.units:
+		return -1
+	case a.units > b.units:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (a Amount) IsZero() bool {
+	return a.units == 0
+}
+
+func (a Amount) IsNegative() bool {
+	return a.units < 0
+}
+
+// Round rounds the amount half away from zero to the number of decimal places, between 0 and 4.
+func (a Amount) Round(places int) Amount {
+	if places >= 4 {
+		return a
+	}
+	if places < 0 {
+		places = 0
+	}
+	step := int64(1)
+	for i := places; i < 4; i++ {
+		step *= 10
+	}
+	units := a.units
+	if units < 0 {
+		units -= step / 2
+	} else {
+		units += step / 2
+	}
+	return Amount{units: units / step * step}
+}
+
+// Cents returns the amount in cents, rounded half away from zero.
+func (a Amount) Cents() int64 {
+	return a.Round(2).units / (amountScale / 100)
+}
+
+// Float64 returns the closest float64 to the amount. It is intended for display and comparisons, not arithmetic.
+func (a Amount) Float64() float64 {
+	return float64(a.units) / amountScale
+}
+
+// String formats the amount with two decimal places, or up to four when the amount has fractions of a cent.
+func (a Amount) String() string {
+	s := a.StringFixed(4)
+	trimmed := strings.TrimRight(s, "0")
+	if decimals := len(trimmed) - strings.IndexByte(trimmed, '.') - 1; decimals < 2 {
+		trimmed += strings.Repeat("0", 2-decimals)
+	}
+	return trimmed
+}
+
+// StringFixed formats the amount rounded to the number of decimal places, such as 0 for currencies without minor
+// units.
+func (a Amount) StringFixed(places int) string {
+	if places > 4 {
+		places = 4
+	}
+	if places < 0 {
+		places = 0
+	}
+	rounded := a.Round(places).units
+	sign := ""
+	if rounded < 0 {
+		sign = "-"
+		rounded = -rounded
+	}
+	whole := strconv.FormatInt(rounded/amountScale, 10)
+	if places == 0 {
+		return sign + whole
+	}
+	fraction := fmt.Sprintf("%04d", rounded%amountScale)[:places]
+	return sign + whole + "." + fraction
+}
+
+// MarshalText formats the amount with two decimal places, the precision of amounts sent to the API. Fractions of a
+// cent are rounded half away from zero, so 0.0125 is sent as 0.01.
+func (a Amount) MarshalText() ([]byte, error) {
+	return []byte(a.StringFixed(2)), nil
+}
+
+// UnmarshalText parses the amount with ParseAmount. An empty value is treated as 0.00.
+func (a *Amount) UnmarshalText(text []byte) error {
+	if len(bytes.TrimSpace(text)) == 0 {
+		*a = Amount{}
+		return nil
+	}
+	parsed, err := ParseAmount(string(text))
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// UnmarshalJSON accepts both JSON numbers and strings.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		data = []byte(s)
+	}
+	return a.UnmarshalText(data)
+}
@@ -0,0 +1,231 @@
+package authnet
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+)
+
+func TestParseAmount(t *testing.T) {
+	cases := []struct {
+		s     string
+		units int64
+		valid bool
+	}{
+		{"19.99", 199900, true},
+		{"0.0125", 125, true},
+		{"1.50000", 15000, true},
+		{"-5", -50000, true},
+		{"+5.1", 51000, true},
+		{" 7 ", 70000, true},
+		{".5", 5000, true},
+		{"3.", 30000, true},
+		{"99999999999999.9999", 999999999999999999, true},
+		{"0.00001", 0, false},
+		{"1.23456", 0, false},
+		{"100000000000000", 0, false},
+		{"", 0, false},
+		{".", 0, false},
+		{"-", 0, false},
+		{"--5", 0, false},
+		{"+-5", 0, false},
+		{"1,000.00", 0, false},
+		{"1e6", 0, false},
+		{"12.3a", 0, false},
+	}
+	for _, c := range cases {
+		amount, err := ParseAmount(c.s)
+		if (err == nil) != c.valid {
+			t.Errorf("%q: expected valid %t, got %v", c.s, c.valid, err)
+			continue
+		}
+		if c.valid && amount.units != c.units {
+			t.Errorf("%q: expected %d units, got %d", c.s, c.units, amount.units)
+		}
+	}
+}
+
+func TestAmountRound(t *testing.T) {
+	cases := []struct {
+		amount string
+		places int
+		want   string
+	}{
+		{"1.005", 2, "1.01"},
+		{"1.0049", 2, "1.00"},
+		{"-1.005", 2, "-1.01"},
+		{"-1.0049", 2, "-1.00"},
+		{"2.5", 0, "3.00"},
+		{"-2.5", 0, "-3.00"},
+		{"0.0125", 3, "0.013"},
+		{"0.0125", 4, "0.0125"},
+		{"0.0125", 7, "0.0125"},
+		{"9.99", -1, "10.00"},
+	}
+	for _, c := range cases {
+		if got := MustParseAmount(c.amount).Round(c.places).String(); got != c.want {
+			t.Errorf("%s rounded to %d: expected %s, got %s", c.amount, c.places, c.want, got)
+		}
+	}
+}
+
+func TestAmountString(t *testing.T) {
+	cases := []struct {
+		amount Amount
+		want   string
+	}{
+		{Amount{}, "0.00"},
+		{AmountFromInt(1000000), "1000000.00"},
+		{AmountFromCents(1999), "19.99"},
+		{AmountFromCents(-5), "-0.05"},
+		{MustParseAmount("1.5"), "1.50"},
+		{MustParseAmount("0.125"), "0.125"},
+		{MustParseAmount("-0.0001"), "-0.0001"},
+	}
+	for _, c := range cases {
+		if got := c.amount.String(); got != c.want {
+			t.Errorf("expected %s, got %s", c.want, got)
+		}
+	}
+}
+
+func TestAmountStringFixed(t *testing.T) {
+	cases := []struct {
+		amount string
+		places int
+		want   string
+	}{
+		{"1234.5678", 0, "1235"},
+		{"1234.5678", 2, "1234.57"},
+		{"1234.5678", 4, "1234.5678"},
+		{"1234.5678", 6, "1234.5678"},
+		{"1234.5678", -2, "1235"},
+		{"-0.004", 2, "0.00"},
+		{"-0.005", 2, "-0.01"},
+		{"5", 3, "5.000"},
+	}
+	for _, c := range cases {
+		if got := MustParseAmount(c.amount).StringFixed(c.places); got != c.want {
+			t.Errorf("%s with %d places: expected %s, got %s", c.amount, c.places, c.want, got)
+		}
+	}
+}
+
+func TestAmountCents(t *testing.T) {
+	cases := []struct {
+		amount string
+		cents  int64
+	}{
+		{"19.99", 1999},
+		{"0.005", 1},
+		{"0.0049", 0},
+		{"-0.005", -1},
+		{"-12.345", -1235},
+		{"100", 10000},
+	}
+	for _, c := range cases {
+		if cents := MustParseAmount(c.amount).Cents(); cents != c.cents {
+			t.Errorf("%s: expected %d cents, got %d", c.amount, c.cents, cents)
+		}
+	}
+}
+
+func TestAmountArithmetic(t *testing.T) {
+	a, b := MustParseAmount("10.10"), MustParseAmount("0.20")
+	if got := a.Add(b).String(); got != "10.30" {
+		t.Errorf("Add: got %s", got)
+	}
+	if got := b.Sub(a).String(); got != "-9.90" {
+		t.Errorf("Sub: got %s", got)
+	}
+	if got := b.Mul(3).String(); got != "0.60" {
+		t.Errorf("Mul: got %s", got)
+	}
+	if a.Cmp(b) != 1 || b.Cmp(a) != -1 || a.Cmp(a) != 0 {
+		t.Errorf("unexpected Cmp results")
+	}
+	if !a.Neg().IsNegative() || a.IsNegative() || !(Amount{}).IsZero() {
+		t.Errorf("unexpected sign results")
+	}
+}
+
+func TestMustParseAmountPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected a panic")
+		}
+	}()
+	MustParseAmount("1.23456")
+}
+
+type amountDocument struct {
+	XMLName xml.Name `xml:"document" json:"-"`
+	Total   Amount   `xml:"total" json:"total"`
+	Tax     *Amount  `xml:"tax,omitempty" json:"tax,omitempty"`
+}
+
+func TestAmountXML(t *testing.T) {
+	tax := MustParseAmount("0.0125")
+	document := amountDocument{Total: AmountFromInt(1000000), Tax: &tax}
+	encoded, err := xml.Marshal(document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded) != "<document><total>1000000.00</total><tax>0.01</tax></document>" {
+		t.Errorf("unexpected XML %s", encoded)
+	}
+	var decoded amountDocument
+	if err := xml.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Total != document.Total || decoded.Tax == nil || *decoded.Tax != tax.Round(2) {
+		t.Errorf("unexpected round trip %+v", decoded)
+	}
+	if err := xml.Unmarshal([]byte("<document><total></total></document>"), &decoded); err != nil || !decoded.Total.IsZero() {
+		t.Errorf("expected an empty element to be 0.00, got %s %v", decoded.Total, err)
+	}
+	if err := xml.Unmarshal([]byte("<document><total>1.23456</total></document>"), &decoded); err == nil {
+		t.Errorf("expected an error for 5 decimal places")
+	}
+}
+
+func TestAmountMarshalsCents(t *testing.T) {
+	cases := []struct {
+		amount Amount
+		want   string
+	}{
+		{MustParseAmount("1.2345"), "1.23"},
+		{MustParseAmount("1.235"), "1.24"},
+		{MustParseAmount("-1.235"), "-1.24"},
+		{MustParseAmount("0.0049"), "0.00"},
+		{MustParseAmount("19.9"), "19.90"},
+		{MustParseAmount("0.1").Mul(3), "0.30"},
+	}
+	for _, c := range cases {
+		text, err := c.amount.MarshalText()
+		if err != nil || string(text) != c.want {
+			t.Errorf("%s: expected %s, got %s %v", c.amount, c.want, text, err)
+		}
+	}
+}
+
+func TestAmountJSON(t *testing.T) {
+	document := amountDocument{Total: MustParseAmount("-19.99")}
+	encoded, err := json.Marshal(document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded) != `{"total":"-19.99"}` {
+		t.Errorf("unexpected JSON %s", encoded)
+	}
+	var decoded amountDocument
+	if err := json.Unmarshal(encoded, &decoded); err != nil || decoded.Total != document.Total {
+		t.Errorf("unexpected round trip %+v %v", decoded, err)
+	}
+	if err := json.Unmarshal([]byte(`{"total":19.5,"tax":null}`), &decoded); err != nil || decoded.Total.String() != "19.50" || decoded.Tax != nil {
+		t.Errorf("expected a JSON number to be accepted, got %+v %v", decoded, err)
+	}
+	if err := json.Unmarshal([]byte(`{"total":1e3}`), &decoded); err == nil {
+		t.Errorf("expected an error for an exponent")
+	}
+}
@@ -0,0 +1,325 @@
+package authnet
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+)
+
+type ARBSubscriptionUnitEnum = string
+
+const (
+	ARBSubscriptionUnitDays   ARBSubscriptionUnitEnum = "days"
+	ARBSubscriptionUnitMonths                         = "months"
+)
+
+// PaymentScheduleInterval is the time between subscription payments. Length must be between 7 and 365 for days and
+// between 1 and 12 for months.
+type PaymentScheduleInterval struct {
+	Length int                     `xml:"length" validation:"required,min=1,max=365"`
+	Unit   ARBSubscriptionUnitEnum `xml:"unit" validation:"required,oneOf=days months"`
+}
+
+// Validate checks Length against the range allowed for Unit.
+func (i PaymentScheduleInterval) Validate() error {
+	switch i.Unit {
+	case ARBSubscriptionUnitDays:
+		if i.Length < 7 || i.Length > 365 {
+			return fmt.Errorf("interval length must be between 7 and 365 days, got %d", i.Length)
+		}
+	case ARBSubscriptionUnitMonths:
+		if i.Length < 1 || i.Length > 12 {
+			return fmt.Errorf("interval length must be between 1 and 12 months, got %d", i.Length)
+		}
+	}
+	return nil
+}
+
+// PaymentScheduleType defines when and how often a subscription is billed. A TotalOccurrences of 9999 creates a
+// subscription with no end date.
+type PaymentScheduleType struct {
+	Interval         *PaymentScheduleInterval `xml:"interval,omitempty"`
+	StartDate        *Date                    `xml:"startDate,omitempty"`
+	TotalOccurrences *int                     `xml:"totalOccurrences,omitempty" validation:"min=1,max=32000"`
+	TrialOccurrences *int                     `xml:"trialOccurrences,omitempty" validation:"min=0,max=32000"`
+}
+
+// ARBSubscriptionType is the subscription used when creating or updating a subscription.
+//
+// Either Payment or Profile is used to charge the subscription. When updating a subscription only the fields that
+// change need to be set.
+type ARBSubscriptionType struct {
+	Name            string                 `xml:"name,omitempty" validation:"max=50"`
+	PaymentSchedule *PaymentScheduleType   `xml:"paymentSchedule,omitempty"`
+	Amount          *Amount                `xml:"amount,omitempty"`
+	TrialAmount     *Amount                `xml:"trialAmount,omitempty"`
+	Payment         *PaymentType           `xml:"payment,omitempty"`
+	Order           *OrderType             `xml:"order,omitempty"`
+	Customer        *CustomerType          `xml:"customer,omitempty"`
+	BillTo          *NameAndAddressType    `xml:"billTo,omitempty"`
+	ShipTo          *NameAndAddressType    `xml:"shipTo,omitempty"`
+	Profile         *CustomerProfileIdType `xml:"profile,omitempty"`
+}
+
+type ARBSubscriptionStatusEnum = string
+
+const (
+	ARBSubscriptionStatusActive     ARBSubscriptionStatusEnum = "active"
+	ARBSubscriptionStatusExpired                              = "expired"
+	ARBSubscriptionStatusSuspended                            = "suspended"
+	ARBSubscriptionStatusCanceled                             = "canceled"
+	ARBSubscriptionStatusTerminated                           = "terminated"
+)
+
+// SubscriptionCustomerProfileType is the customer profile, payment profile and shipping address charged by a
+// subscription.
+type SubscriptionCustomerProfileType struct {
+	CustomerProfileExType
+	PaymentProfile  *CustomerPaymentProfileMaskedType `xml:"paymentProfile,omitempty"`
+	ShippingProfile *CustomerAddressExType            `xml:"shippingProfile,omitempty"`
+}
+
+type ARBTransaction struct {
+	TransId       string    `xml:"transId,omitempty"`
+	Response      string    `xml:"response,omitempty"`
+	SubmitTimeUTC *DateTime `xml:"submitTimeUTC,omitempty"`
+	PayNum        int       `xml:"payNum,omitempty"`
+	AttemptNum    int       `xml:"attemptNum,omitempty"`
+}
+
+type ARBTransactionList struct {
+	ARBTransaction []ARBTransaction `xml:"arbTransaction,omitempty"`
+}
+
+// ARBSubscriptionMaskedType is the subscription returned by the gateway with the sensitive payment information masked.
+type ARBSubscriptionMaskedType struct {
+	Name            string                           `xml:"name,omitempty"`
+	PaymentSchedule *PaymentScheduleType             `xml:"paymentSchedule,omitempty"`
+	Amount          *Amount                          `xml:"amount,omitempty"`
+	TrialAmount     *Amount                          `xml:"trialAmount,omitempty"`
+	Status          ARBSubscriptionStatusEnum        `xml:"status,omitempty"`
+	Profile         *SubscriptionCustomerProfileType `xml:"profile,omitempty"`
+	Order           *OrderType                       `xml:"order,omitempty"`
+	ArbTransactions *ARBTransactionList              `xml:"arbTransactions,omitempty"`
+}
+
+type ARBCreateSubscriptionRequest struct {
+	ANetApiRequest
+	XMLName      xml.Name            `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd ARBCreateSubscriptionRequest"`
+	Subscription ARBSubscriptionType `xml:"subscription" validation:"required"`
+}
+
+type ARBCreateSubscriptionResponse struct {
+	ANetApiResponse
+	XMLName        xml.Name               `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd ARBCreateSubscriptionResponse"`
+	SubscriptionId string                 `xml:"subscriptionId,omitempty"`
+	Profile        *CustomerProfileIdType `xml:"profile,omitempty"`
+}
+
+type ARBUpdateSubscriptionRequest struct {
+	ANetApiRequest
+	XMLName        xml.Name            `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd ARBUpdateSubscriptionRequest"`
+	SubscriptionId string              `xml:"subscriptionId" validation:"required,numeric"`
+	Subscription   ARBSubscriptionType `xml:"subscription" validation:"required"`
+}
+
+type ARBUpdateSubscriptionResponse struct {
+	ANetApiResponse
+	XMLName xml.Name               `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd ARBUpdateSubscriptionResponse"`
+	Profile *CustomerProfileIdType `xml:"profile,omitempty"`
+}
+
+type ARBCancelSubscriptionRequest struct {
+	ANetApiRequest
+	XMLName        xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd ARBCancelSubscriptionRequest"`
+	SubscriptionId string   `xml:"subscriptionId" validation:"required,numeric"`
+}
+
+type ARBCancelSubscriptionResponse struct {
+	ANetApiResponse
+	XMLName xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd ARBCancelSubscriptionResponse"`
+}
+
+type ARBGetSubscriptionStatusRequest struct {
+	ANetApiRequest
+	XMLName        xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd ARBGetSubscriptionStatusRequest"`
+	SubscriptionId string   `xml:"subscriptionId" validation:"required,numeric"`
+}
+
+type ARBGetSubscriptionStatusResponse struct {
+	ANetApiResponse
+	XMLName xml.Name                  `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd ARBGetSubscriptionStatusResponse"`
+	Status  ARBSubscriptionStatusEnum `xml:"status,omitempty"`
+}
+
+type ARBGetSubscriptionRequest struct {
+	ANetApiRequest
+	XMLName             xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd ARBGetSubscriptionRequest"`
+	SubscriptionId      string   `xml:"subscriptionId" validation:"required,numeric"`
+	IncludeTransactions *bool    `xml:"includeTransactions,omitempty"`
+}
+
+type ARBGetSubscriptionResponse struct {
+	ANetApiResponse
+	XMLName      xml.Name                   `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd ARBGetSubscriptionResponse"`
+	Subscription *ARBSubscriptionMaskedType `xml:"subscription,omitempty"`
+}
+
+type ARBGetSubscriptionListSearchTypeEnum = string
+
+const (
+	ARBGetSubscriptionListSearchTypeCardExpiringThisMonth         ARBGetSubscriptionListSearchTypeEnum = "cardExpiringThisMonth"
+	ARBGetSubscriptionListSearchTypeSubscriptionActive                                                 = "subscriptionActive"
+	ARBGetSubscriptionListSearchTypeSubscriptionExpiringThisMonth                                      = "subscriptionExpiringThisMonth"
+	ARBGetSubscriptionListSearchTypeSubscriptionInactive                                               = "subscriptionInactive"
+)
+
+type ARBGetSubscriptionListOrderFieldEnum = string
+
+const (
+	ARBGetSubscriptionListOrderFieldId                 ARBGetSubscriptionListOrderFieldEnum = "id"
+	ARBGetSubscriptionListOrderFieldName                                                    = "name"
+	ARBGetSubscriptionListOrderFieldStatus                                                  = "status"
+	ARBGetSubscriptionListOrderFieldCreateTimeStampUTC                                      = "createTimeStampUTC"
+	ARBGetSubscriptionListOrderFieldLastName                                                = "lastName"
+	ARBGetSubscriptionListOrderFieldFirstName                                               = "firstName"
+	ARBGetSubscriptionListOrderFieldAccountNumber                                           = "accountNumber"
+	ARBGetSubscriptionListOrderFieldAmount                                                  = "amount"
+	ARBGetSubscriptionListOrderFieldPastOccurrences                                         = "pastOccurrences"
+)
+
+type ARBGetSubscriptionListSorting struct {
+	OrderBy         ARBGetSubscriptionListOrderFieldEnum `xml:"orderBy" validation:"required,oneOf=id name status createTimeStampUTC lastName firstName accountNumber amount pastOccurrences"`
+	OrderDescending bool                                 `xml:"orderDescending"`
+}
+
+type ARBGetSubscriptionListRequest struct {
+	ANetApiRequest
+	XMLName    xml.Name                             `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd ARBGetSubscriptionListRequest"`
+	SearchType ARBGetSubscriptionListSearchTypeEnum `xml:"searchType" validation:"required,oneOf=cardExpiringThisMonth subscriptionActive subscriptionExpiringThisMonth subscriptionInactive"`
+	Sorting    *ARBGetSubscriptionListSorting       `xml:"sorting,omitempty"`
+	Paging     *Paging                              `xml:"paging,omitempty"`
+}
+
+type PaymentMethodEnum = string
+
+const (
+	PaymentMethodCreditCard PaymentMethodEnum = "creditCard"
+	PaymentMethodECheck                       = "eCheck"
+	PaymentMethodPayPal                       = "payPal"
+)
+
+// SubscriptionDetail is the summary of a subscription returned by ARBGetSubscriptionList.
+type SubscriptionDetail struct {
+	Id                        string                    `xml:"id"`
+	Name                      string                    `xml:"name,omitempty"`
+	Status                    ARBSubscriptionStatusEnum `xml:"status"`
+	CreateTimeStampUTC        *DateTime                 `xml:"createTimeStampUTC,omitempty"`
+	FirstName                 string                    `xml:"firstName,omitempty"`
+	LastName                  string                    `xml:"lastName,omitempty"`
+	TotalOccurrences          int                       `xml:"totalOccurrences"`
+	PastOccurrences           int                       `xml:"pastOccurrences"`
+	PaymentMethod             PaymentMethodEnum         `xml:"paymentMethod"`
+	AccountNumber             string                    `xml:"accountNumber,omitempty"`
+	Invoice                   string                    `xml:"invoice,omitempty"`
+	Amount                    Amount                    `xml:"amount"`
+	CurrencyCode              string                    `xml:"currencyCode,omitempty"`
+	CustomerProfileId         string                    `xml:"customerProfileId"`
+	CustomerPaymentProfileId  string                    `xml:"customerPaymentProfileId"`
+	CustomerShippingProfileId string                    `xml:"customerShippingProfileId,omitempty"`
+}
+
+type ArrayOfSubscription struct {
+	SubscriptionDetail []SubscriptionDetail `xml:"subscriptionDetail,omitempty"`
+}
+
+type ARBGetSubscriptionListResponse struct {
+	ANetApiResponse
+	XMLName             xml.Name             `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd ARBGetSubscriptionListResponse"`
+	TotalNumInResultSet int                  `xml:"totalNumInResultSet"`
+	SubscriptionDetails *ArrayOfSubscription `xml:"subscriptionDetails,omitempty"`
+}
+
+// ARBCreateSubscription creates a new recurring billing subscription.
+func (c *AuthNetClient) ARBCreateSubscription(req ARBCreateSubscriptionRequest) (*ARBCreateSubscriptionResponse, error) {
+	return c.ARBCreateSubscriptionContext(context.Background(), req)
+}
+
+// ARBCreateSubscriptionContext is like ARBCreateSubscription but uses ctx for the request.
+func (c *AuthNetClient) ARBCreateSubscriptionContext(ctx context.Context, req ARBCreateSubscriptionRequest) (*ARBCreateSubscriptionResponse, error) {
+	var res ARBCreateSubscriptionResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ARBUpdateSubscription updates an existing subscription. Only the fields set on the subscription are changed.
+func (c *AuthNetClient) ARBUpdateSubscription(req ARBUpdateSubscriptionRequest) (*ARBUpdateSubscriptionResponse, error) {
+	return c.ARBUpdateSubscriptionContext(context.Background(), req)
+}
+
+// ARBUpdateSubscriptionContext is like ARBUpdateSubscription but uses ctx for the request.
+func (c *AuthNetClient) ARBUpdateSubscriptionContext(ctx context.Context, req ARBUpdateSubscriptionRequest) (*ARBUpdateSubscriptionResponse, error) {
+	var res ARBUpdateSubscriptionResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ARBCancelSubscription cancels an existing subscription.
+func (c *AuthNetClient) ARBCancelSubscription(req ARBCancelSubscriptionRequest) (*ARBCancelSubscriptionResponse, error) {
+	return c.ARBCancelSubscriptionContext(context.Background(), req)
+}
+
+// ARBCancelSubscriptionContext is like ARBCancelSubscription but uses ctx for the request.
+func (c *AuthNetClient) ARBCancelSubscriptionContext(ctx context.Context, req ARBCancelSubscriptionRequest) (*ARBCancelSubscriptionResponse, error) {
+	var res ARBCancelSubscriptionResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ARBGetSubscriptionStatus retrieves the status of an existing subscription.
+func (c *AuthNetClient) ARBGetSubscriptionStatus(req ARBGetSubscriptionStatusRequest) (*ARBGetSubscriptionStatusResponse, error) {
+	return c.ARBGetSubscriptionStatusContext(context.Background(), req)
+}
+
+// ARBGetSubscriptionStatusContext is like ARBGetSubscriptionStatus but uses ctx for the request.
+func (c *AuthNetClient) ARBGetSubscriptionStatusContext(ctx context.Context, req ARBGetSubscriptionStatusRequest) (*ARBGetSubscriptionStatusResponse, error) {
+	var res ARBGetSubscriptionStatusResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ARBGetSubscription retrieves an existing subscription and optionally the transactions it has produced.
+func (c *AuthNetClient) ARBGetSubscription(req ARBGetSubscriptionRequest) (*ARBGetSubscriptionResponse, error) {
+	return c.ARBGetSubscriptionContext(context.Background(), req)
+}
+
+// ARBGetSubscriptionContext is like ARBGetSubscription but uses ctx for the request.
+func (c *AuthNetClient) ARBGetSubscriptionContext(ctx context.Context, req ARBGetSubscriptionRequest) (*ARBGetSubscriptionResponse, error) {
+	var res ARBGetSubscriptionResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ARBGetSubscriptionList searches the subscriptions of the merchant.
+func (c *AuthNetClient) ARBGetSubscriptionList(req ARBGetSubscriptionListRequest) (*ARBGetSubscriptionListResponse, error) {
+	return c.ARBGetSubscriptionListContext(context.Background(), req)
+}
+
+// ARBGetSubscriptionListContext is like ARBGetSubscriptionList but uses ctx for the request.
+func (c *AuthNetClient) ARBGetSubscriptionListContext(ctx context.Context, req ARBGetSubscriptionListRequest) (*ARBGetSubscriptionListResponse, error) {
+	var res ARBGetSubscriptionListResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,23 @@
+package authnet
+
+import "testing"
+
+func TestPaymentScheduleIntervalValidate(t *testing.T) {
+	cases := []struct {
+		interval PaymentScheduleInterval
+		valid    bool
+	}{
+		{PaymentScheduleInterval{Length: 7, Unit: ARBSubscriptionUnitDays}, true},
+		{PaymentScheduleInterval{Length: 365, Unit: ARBSubscriptionUnitDays}, true},
+		{PaymentScheduleInterval{Length: 6, Unit: ARBSubscriptionUnitDays}, false},
+		{PaymentScheduleInterval{Length: 366, Unit: ARBSubscriptionUnitDays}, false},
+		{PaymentScheduleInterval{Length: 1, Unit: ARBSubscriptionUnitMonths}, true},
+		{PaymentScheduleInterval{Length: 12, Unit: ARBSubscriptionUnitMonths}, true},
+		{PaymentScheduleInterval{Length: 13, Unit: ARBSubscriptionUnitMonths}, false},
+	}
+	for _, c := range cases {
+		if err := Validate(c.interval); (err == nil) != c.valid {
+			t.Errorf("%d %s: expected valid %t, got %v", c.interval.Length, c.interval.Unit, c.valid, err)
+		}
+	}
+}
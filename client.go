@@ -2,8 +2,10 @@ package authnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"errors"
+	"fmt"
 	"io"
 	"net"
 	"net/http"
@@ -12,9 +14,10 @@ import (
 )
 
 type AuthNetClient struct {
-	config     Config
-	apiUrl     string
-	httpClient http.Client
+	config       Config
+	apiUrl       string
+	httpClient   http.Client
+	capabilities *capabilitiesCache
 }
 
 func NewAuthNetClient(config Config) AuthNetClient {
@@ -41,6 +44,7 @@ func NewAuthNetClient(config Config) AuthNetClient {
 		httpClient: http.Client{
 			Transport: transport,
 		},
+		capabilities: new(capabilitiesCache),
 	}
 }
 
@@ -52,6 +56,11 @@ func (c *AuthNetClient) CreateMerchantAuthenticationType() MerchantAuthenticatio
 }
 
 func (c *AuthNetClient) AuthenticateTest() (*AuthenticateTestResponse, error) {
+	return c.AuthenticateTestContext(context.Background())
+}
+
+// AuthenticateTestContext is like AuthenticateTest but uses ctx for the request.
+func (c *AuthNetClient) AuthenticateTestContext(ctx context.Context) (*AuthenticateTestResponse, error) {
 	testRequest := AuthenticateTestRequest{
 		MerchantAuthentication: MerchantAuthentication{
 			Name:           c.config.Auth.ApiLoginId,
@@ -59,62 +68,206 @@ func (c *AuthNetClient) AuthenticateTest() (*AuthenticateTestResponse, error) {
 		},
 	}
 	var testResponse AuthenticateTestResponse
-	rErr := c.SendRequest(testRequest, &testResponse)
-	return &testResponse, rErr
+	if rErr := c.SendRequestContext(ctx, testRequest, &testResponse); rErr != nil {
+		return &testResponse, rErr
+	}
+	return &testResponse, nil
 }
 
 // RequestError contains the common.ErrorResponse or errors from some other cause. Either could be populated or one of
 // them. This fulfills the error interface and provides the Error() function.
+//
+// StatusCode and Body are set when the gateway responded with a non 2xx HTTP status or a body that could not be
+// unmarshalled. TransactionErrors is set by CreateTransaction when the transaction was declined or failed.
+//
+// A RequestError unwraps to Err, an *APIError for each message of the response and a *TransactionError for each
+// transaction error, so that errors.Is matches sentinels such as ErrRecordNotFound and errors.As extracts the typed
+// errors. Err is a *TransportError when the request could not be sent or the response could not be read.
 type RequestError struct {
-	Response *ErrorResponse
-	Err      error
+	Response          *ErrorResponse
+	TransactionErrors []Error
+	Err               error
+	StatusCode        int
+	Body              []byte
 }
 
 func (e *RequestError) Error() string {
-	if e.Response != nil {
-		messageErr := errors.New(e.Response.Messages.Message[0].Text)
-		if e.Err != nil {
-			messageErr = errors.Join(messageErr, e.Err)
-		}
-		return messageErr.Error()
-	} else if e.Err != nil {
-		return e.Err.Error()
-	} else {
-		return ""
+	var messages []string
+	for _, message := range e.Codes() {
+		messages = append(messages, message.Text)
+	}
+	for _, transactionError := range e.TransactionErrors {
+		messages = append(messages, transactionError.ErrorText)
+	}
+	if e.Response != nil && len(messages) == 0 {
+		messages = append(messages, "request failed with result code "+e.Response.Messages.ResultCode)
+	}
+	if e.Err != nil {
+		messages = append(messages, e.Err.Error())
 	}
+	return strings.Join(messages, "\n")
+}
+
+// Codes returns every message of the error response, or nil when the request failed for another reason.
+func (e *RequestError) Codes() []Message {
+	if e.Response == nil {
+		return nil
+	}
+	return e.Response.Messages.Message
+}
+
+// Unwrap returns Err along with the messages and transaction errors as *APIError and *TransactionError values, so
+// that errors.Is can match the Err sentinels as well as causes such as context.Canceled or context.DeadlineExceeded.
+func (e *RequestError) Unwrap() []error {
+	var errs []error
+	if e.Err != nil {
+		errs = append(errs, e.Err)
+	}
+	for _, message := range e.Codes() {
+		errs = append(errs, &APIError{Code: message.Code, Text: message.Text})
+	}
+	for _, transactionError := range e.TransactionErrors {
+		errs = append(errs, &TransactionError{Code: transactionError.ErrorCode, Text: transactionError.ErrorText})
+	}
+	return errs
+}
+
+// IsTransportError reports whether the request could not be sent or the response could not be read.
+func (e *RequestError) IsTransportError() bool {
+	var transportErr *TransportError
+	return errors.As(e.Err, &transportErr)
+}
+
+// IsContextError reports whether the request failed because its context was canceled or its deadline was exceeded.
+func (e *RequestError) IsContextError() bool {
+	return errors.Is(e.Err, context.Canceled) || errors.Is(e.Err, context.DeadlineExceeded)
 }
 
 // SendRequest takes a request type instance and a response type instance. req can be passed either by reference or by
 // value. The res however, is required to be a reference due to the unmarshalling phase of the request.
+//
+// When Config.ValidateRequests is set, req is checked with Validate first and a *ValidationError is returned in
+// RequestError.Err without sending the request.
 func (c *AuthNetClient) SendRequest(req any, res any) *RequestError {
-	var requestError RequestError
+	return c.SendRequestContext(context.Background(), req, res)
+}
+
+// SendRequestContext is like SendRequest but uses ctx for the request. Canceling ctx or exceeding its deadline aborts
+// the request while dialing, writing the request or reading the response, in which case RequestError.Err matches
+// ctx.Err() with errors.Is.
+//
+// When Config.Retry allows more than one attempt, failed attempts that are safe to repeat are retried with the same
+// request body. See Retry for the conditions.
+func (c *AuthNetClient) SendRequestContext(ctx context.Context, req any, res any) *RequestError {
+	if c.config.ValidateRequests {
+		if vErr := Validate(req); vErr != nil {
+			return &RequestError{Err: vErr}
+		}
+	}
 	bodyBytes, mErr := xml.Marshal(req)
 	if mErr != nil {
-		requestError.Err = errors.Join(errors.New("unable to marshal request body"), mErr)
+		return &RequestError{Err: errors.Join(errors.New("unable to marshal request body"), mErr)}
+	}
+	policy := c.config.Retry.policy()
+	for attempt := 1; ; attempt++ {
+		rErr := c.post(ctx, bodyBytes, res)
+		if attempt >= policy.MaxAttempts || ctx.Err() != nil || !shouldRetry(req, res, rErr) {
+			return rErr
+		}
+		if wErr := policy.wait(ctx, attempt); wErr != nil {
+			return rErr
+		}
+		resetResponse(res)
+	}
+}
+
+// post makes a single attempt at sending the marshalled request and unmarshalling the response into res.
+func (c *AuthNetClient) post(ctx context.Context, bodyBytes []byte, res any) *RequestError {
+	var requestError RequestError
+	httpRequest, nrErr := http.NewRequestWithContext(ctx, http.MethodPost, c.apiUrl, bytes.NewReader(bodyBytes))
+	if nrErr != nil {
+		requestError.Err = errors.Join(errors.New("unable to create http request"), nrErr)
 		return &requestError
 	}
-	response, reqErr := c.httpClient.Post(c.apiUrl, "text/xml", bytes.NewReader(bodyBytes))
+	httpRequest.Header.Set("Content-Type", "text/xml")
+	response, reqErr := c.httpClient.Do(httpRequest)
 	if reqErr != nil {
-		requestError.Err = errors.Join(errors.New("unable to make http request"), reqErr)
+		requestError.Err = &TransportError{Err: errors.Join(errors.New("unable to make http request"), reqErr)}
 		return &requestError
 	}
 	defer response.Body.Close()
-	resBytes := make([]byte, response.ContentLength)
-	nRead, readErr := response.Body.Read(resBytes)
-	if nRead != int(response.ContentLength) && readErr == io.EOF {
-		requestError.Err = errors.Join(errors.New("unable to read response body"), reqErr)
+	resBytes, readErr := c.readBody(response)
+	if readErr != nil {
+		readErr = errors.Join(errors.New("unable to read response body"), readErr)
+		if ctxErr := ctx.Err(); ctxErr != nil && !errors.Is(readErr, ctxErr) {
+			readErr = errors.Join(readErr, ctxErr)
+		}
+		requestError.Err = &TransportError{Err: readErr}
+		return &requestError
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		requestError.StatusCode = response.StatusCode
+		requestError.Body = resBytes
+		requestError.Err = &TransportError{Err: fmt.Errorf("unexpected http status %s", response.Status)}
 		return &requestError
 	}
 	if uErr := xml.Unmarshal(resBytes, res); uErr != nil {
 		// check if response is ErrorResponse
 		var errResponse ErrorResponse
 		if ueErr := xml.Unmarshal(resBytes, &errResponse); ueErr != nil {
-			requestError.Err = errors.Join(errors.New("unable to unmarshal response body"), reqErr, ueErr)
+			requestError.Body = resBytes
+			requestError.Err = errors.Join(errors.New("unable to unmarshal response body"), uErr)
 		} else {
-			requestError.Err = reqErr
 			requestError.Response = &errResponse
 		}
 		return &requestError
 	}
 	return nil
 }
+
+// utf8BOM is the byte order mark Authorize.net prefixes to its XML responses.
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// readBody reads the whole response body regardless of its transfer encoding, up to the configured maximum size, and
+// strips the leading byte order mark.
+func (c *AuthNetClient) readBody(response *http.Response) ([]byte, error) {
+	maxSize := c.config.MaxResponseBodySize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxResponseBodySize
+	}
+	body, readErr := io.ReadAll(io.LimitReader(response.Body, maxSize+1))
+	if readErr != nil {
+		return nil, readErr
+	}
+	if int64(len(body)) > maxSize {
+		return nil, fmt.Errorf("response body exceeds the maximum size of %d bytes", maxSize)
+	}
+	return bytes.TrimPrefix(body, utf8BOM), nil
+}
+
+// apiResponse is implemented by every response type that embeds ANetApiResponse.
+type apiResponse interface {
+	apiResponse() *ANetApiResponse
+}
+
+func (r *ANetApiResponse) apiResponse() *ANetApiResponse {
+	return r
+}
+
+// send performs SendRequest and additionally treats a response with an Error result code as a failed request. The
+// gateway reports most API level failures this way rather than with an ErrorResponse.
+func (c *AuthNetClient) send(ctx context.Context, req any, res apiResponse) error {
+	if rErr := c.SendRequestContext(ctx, req, res); rErr != nil {
+		return rErr
+	}
+	base := res.apiResponse()
+	if base.Messages != nil && strings.EqualFold(base.Messages.ResultCode, MessageTypeError) {
+		return &RequestError{
+			Response: &ErrorResponse{
+				ANetApiResponse: *base,
+				Messages:        Messages(*base.Messages),
+			},
+		}
+	}
+	return nil
+}
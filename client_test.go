@@ -0,0 +1,139 @@
+package authnet
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// okMessages is the messages element of a successful response.
+const okMessages = `<messages><resultCode>Ok</resultCode><message><code>I00001</code><text>Successful.</text></message></messages>`
+
+// newFakeClient returns a client sending its requests to handler.
+func newFakeClient(t *testing.T, handler http.HandlerFunc) AuthNetClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return NewAuthNetClient(Config{
+		AuthnetHost: server.URL,
+		Auth:        &Auth{ApiLoginId: "login", TransactionKey: "key"},
+	})
+}
+
+// recordedRequest holds the body of the last request sent to a client created by newRecordingClient.
+type recordedRequest struct {
+	mu   sync.Mutex
+	body string
+}
+
+func (r *recordedRequest) String() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.body
+}
+
+// newRecordingClient returns a client answering every request with a successful response named after the request and
+// holding inner, along with the body of the last request sent.
+func newRecordingClient(t *testing.T, inner string) (AuthNetClient, *recordedRequest) {
+	return newRecordingClientNamed(t, "", inner)
+}
+
+// newRecordingClientNamed is like newRecordingClient but names the response element name, for requests the gateway
+// answers with the response of another request.
+func newRecordingClientNamed(t *testing.T, name string, inner string) (AuthNetClient, *recordedRequest) {
+	recorded := new(recordedRequest)
+	client := newFakeClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		recorded.mu.Lock()
+		recorded.body = string(body)
+		recorded.mu.Unlock()
+		var root struct{ XMLName xml.Name }
+		if err := xml.Unmarshal(body, &root); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		responseName := name
+		if len(responseName) == 0 {
+			responseName = strings.TrimSuffix(root.XMLName.Local, "Request") + "Response"
+		}
+		fmt.Fprintf(w, `<?xml version="1.0" encoding="utf-8"?><%s xmlns="%s">%s%s</%s>`,
+			responseName, anetNamespace, okMessages, inner, responseName)
+	})
+	return client, recorded
+}
+
+// operationCase is an operation sent through newRecordingClient. call sends the request and checks the decoded
+// response, request lists fragments the request body must contain in the given order.
+type operationCase struct {
+	name     string
+	response string
+	call     func(t *testing.T, c *AuthNetClient)
+	request  []string
+}
+
+func runOperationCases(t *testing.T, cases []operationCase) {
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, recorded := newRecordingClient(t, tc.response)
+			tc.call(t, &client)
+			assertFragments(t, recorded.String(), tc.request...)
+		})
+	}
+}
+
+// assertFragments checks that body contains every fragment, each after the previous one.
+func assertFragments(t *testing.T, body string, fragments ...string) {
+	t.Helper()
+	rest := body
+	for _, fragment := range fragments {
+		i := strings.Index(rest, fragment)
+		if i < 0 {
+			t.Errorf("expected %q in order in %s", fragment, body)
+			return
+		}
+		rest = rest[i+len(fragment):]
+	}
+}
+
+func TestReadBodyStripsByteOrderMark(t *testing.T) {
+	client := newFakeClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+		w.Write(append([]byte{0xEF, 0xBB, 0xBF}, merchantDetailsResponse...))
+	})
+	res, err := client.GetMerchantDetails(GetMerchantDetailsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.MerchantName != "Test Merchant" {
+		t.Errorf("unexpected response %+v", res)
+	}
+
+	var c AuthNetClient
+	body, err := c.readBody(&http.Response{Body: io.NopCloser(strings.NewReader("\uFEFF<a>\uFEFF</a>"))})
+	if err != nil || string(body) != "<a>\uFEFF</a>" {
+		t.Errorf("expected only the leading byte order mark to be stripped, got %q %v", body, err)
+	}
+}
+
+func TestReadBodyRejectsOversizedBody(t *testing.T) {
+	client := newFakeClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(merchantDetailsResponse))
+		w.Write([]byte(strings.Repeat(" ", 1024)))
+	})
+	client.config.MaxResponseBodySize = int64(len(merchantDetailsResponse))
+	_, err := client.GetMerchantDetails(GetMerchantDetailsRequest{})
+	var transportErr *TransportError
+	if !errors.As(err, &transportErr) || !strings.Contains(err.Error(), "exceeds the maximum size") {
+		t.Errorf("expected a TransportError for the body size, got %v", err)
+	}
+
+	client.config.MaxResponseBodySize = int64(len(merchantDetailsResponse)) + 1024
+	if _, err := client.GetMerchantDetails(GetMerchantDetailsRequest{}); err != nil {
+		t.Errorf("expected a body of exactly the maximum size to be read, got %v", err)
+	}
+}
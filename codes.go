@@ -0,0 +1,450 @@
+package authnet
+
+import "strings"
+
+// CodeInfo describes a transaction response reason code or an API message code.
+//
+// CustomerActionable is set when the customer can resolve the failure, for example by correcting their card details
+// or using another payment method. Retryable is set when the same request may succeed if sent again later unchanged.
+// The client retries the message codes with Retryable set when Config.Retry allows it; transactions failed with a
+// Retryable reason code must be resubmitted by the caller once the duplicate window has passed.
+type CodeInfo struct {
+	Code               string
+	Description        string
+	Suggestion         string
+	CustomerActionable bool
+	Retryable          bool
+}
+
+const (
+	suggestionTryAgain        = "A temporary failure at the gateway or processor. Try again in a few minutes."
+	suggestionOtherPayment    = "The issuer declined the card. Ask the customer to contact their bank or use another payment method."
+	suggestionMerchantSetup   = "The merchant account is not set up correctly at the processor. Contact the merchant service provider."
+	suggestionFraudFilter     = "Declined by a fraud detection filter of the merchant. Review the filter settings in the Merchant Interface."
+	suggestionHeldForReview   = "Held for review by a fraud detection filter. Approve or decline the transaction in the Merchant Interface."
+	suggestionFingerprint     = "The fingerprint of a hosted form request is invalid. Check the transaction key and the timestamp used to generate it."
+	suggestionStatusUnknown   = "The processor did not confirm the outcome. Check the transaction status before charging again, for example with ResolveTransaction."
+	suggestionCheckField      = "Correct the field in the request."
+	suggestionCheckCredential = "Check the API login id and transaction key of the configuration."
+)
+
+// reasonCodes are the transaction response reason codes found in TransactionResponse.Errors and
+// TransactionResponse.Messages.
+var reasonCodes = map[string]CodeInfo{
+	"1":    {Description: "This transaction has been approved."},
+	"2":    {Description: "This transaction has been declined.", Suggestion: suggestionOtherPayment, CustomerActionable: true},
+	"3":    {Description: "This transaction has been declined.", Suggestion: "The issuer requested a voice authorization (referral). Ask the customer to use another payment method.", CustomerActionable: true},
+	"4":    {Description: "This transaction has been declined.", Suggestion: "The issuer asked for the card to be picked up. Do not retry the card.", CustomerActionable: true},
+	"5":    {Description: "A valid amount is required.", Suggestion: "The amount must be greater than zero and have at most two decimal places."},
+	"6":    {Description: "The credit card number is invalid.", Suggestion: "Ask the customer to check the card number.", CustomerActionable: true},
+	"7":    {Description: "Credit card expiration date is invalid.", Suggestion: "The expiration date must be in the MMYY, MM/YY, MM-YY, MMYYYY, MM/YYYY, MM-YYYY or YYYY-MM format.", CustomerActionable: true},
+	"8":    {Description: "The credit card has expired.", Suggestion: "Ask the customer for a card that has not expired.", CustomerActionable: true},
+	"9":    {Description: "The ABA code is invalid.", Suggestion: "Ask the customer to check the routing number of the bank account.", CustomerActionable: true},
+	"10":   {Description: "The account number is invalid.", Suggestion: "Ask the customer to check the bank account number.", CustomerActionable: true},
+	"11":   {Description: "A duplicate transaction has been submitted.", Suggestion: "A transaction with the same amount, payment details and invoice number was submitted within the duplicate window. Check whether the first transaction succeeded, or set the duplicateWindow transaction setting."},
+	"12":   {Description: "An authorization code is required but not present.", Suggestion: "Capture only transactions require the authCode of the voice authorization."},
+	"13":   {Description: "The merchant login ID or password is invalid or the account is inactive.", Suggestion: suggestionCheckCredential},
+	"14":   {Description: "The referrer, relay response or receipt link URL is invalid.", Suggestion: "Add the URL to the Response/Receipt URLs in the Merchant Interface."},
+	"15":   {Description: "The transaction ID is invalid or not present.", Suggestion: "The refTransId must be the transId of an existing transaction."},
+	"16":   {Description: "The transaction cannot be found.", Suggestion: "The refTransId does not exist for this merchant account. Transactions older than 120 days cannot be referenced."},
+	"17":   {Description: "The merchant does not accept this type of credit card.", Suggestion: "Ask the customer to use a card type the merchant accepts.", CustomerActionable: true},
+	"18":   {Description: "ACH transactions are not accepted by this merchant.", Suggestion: "The merchant account is not enabled for eCheck.Net."},
+	"19":   {Description: "An error occurred during processing. Please try again.", Suggestion: suggestionTryAgain, Retryable: true},
+	"20":   {Description: "An error occurred during processing. Please try again.", Suggestion: suggestionTryAgain, Retryable: true},
+	"21":   {Description: "An error occurred during processing. Please try again.", Suggestion: suggestionTryAgain, Retryable: true},
+	"22":   {Description: "An error occurred during processing. Please try again.", Suggestion: suggestionTryAgain, Retryable: true},
+	"23":   {Description: "An error occurred during processing. Please try again.", Suggestion: suggestionTryAgain, Retryable: true},
+	"24":   {Description: "The Elavon bank number or terminal ID is incorrect. Call Merchant Service Provider.", Suggestion: suggestionMerchantSetup},
+	"25":   {Description: "An error occurred during processing. Please try again.", Suggestion: suggestionTryAgain, Retryable: true},
+	"26":   {Description: "An error occurred during processing. Please try again.", Suggestion: suggestionTryAgain, Retryable: true},
+	"27":   {Description: "The transaction has been declined because of an AVS mismatch. The address provided does not match billing address of cardholder.", Suggestion: "Ask the customer to enter the billing address on file with their card issuer.", CustomerActionable: true},
+	"28":   {Description: "The merchant does not accept this type of credit card.", Suggestion: "Ask the customer to use a card type the merchant accepts.", CustomerActionable: true},
+	"29":   {Description: "The Paymentech identification numbers are incorrect. Call Merchant Service Provider.", Suggestion: suggestionMerchantSetup},
+	"30":   {Description: "The configuration with processor is invalid. Call Merchant Service Provider.", Suggestion: suggestionMerchantSetup},
+	"31":   {Description: "The FDC Merchant ID or Terminal ID is incorrect. Call Merchant Service Provider.", Suggestion: suggestionMerchantSetup},
+	"32":   {Description: "The merchant password is invalid or not present.", Suggestion: suggestionCheckCredential},
+	"33":   {Description: "A required field cannot be left blank.", Suggestion: "The error text names the field. Add it to the request or make it optional in the Merchant Interface."},
+	"34":   {Description: "The VITAL identification numbers are incorrect. Call Merchant Service Provider.", Suggestion: suggestionMerchantSetup},
+	"35":   {Description: "An error occurred during processing. Call Merchant Service Provider.", Suggestion: suggestionMerchantSetup},
+	"36":   {Description: "The authorization was approved but settlement failed.", Suggestion: "Submit a new transaction; the approved authorization will expire."},
+	"37":   {Description: "The credit card number is invalid.", Suggestion: "Ask the customer to check the card number.", CustomerActionable: true},
+	"38":   {Description: "The Global Payment System identification numbers are incorrect. Call Merchant Service Provider.", Suggestion: suggestionMerchantSetup},
+	"40":   {Description: "This transaction must be encrypted.", Suggestion: "Send the request over HTTPS."},
+	"41":   {Description: "This transaction has been declined.", Suggestion: suggestionFraudFilter},
+	"43":   {Description: "The merchant was incorrectly set up at the processor. Call Merchant Service Provider.", Suggestion: suggestionMerchantSetup},
+	"44":   {Description: "This transaction has been declined.", Suggestion: "The card code did not match and the merchant's card code filter rejected it. Ask the customer to check the card code.", CustomerActionable: true},
+	"45":   {Description: "This transaction has been declined.", Suggestion: "The address and card code did not match and the merchant's filters rejected it. Ask the customer to check the billing address and card code.", CustomerActionable: true},
+	"46":   {Description: "Your session has expired or does not exist. You must log in again to continue working."},
+	"47":   {Description: "The amount requested for settlement cannot be greater than the original amount authorized.", Suggestion: "Capture at most the authorized amount, or authorize the difference separately."},
+	"48":   {Description: "This processor does not accept partial reversals.", Suggestion: "Capture the full authorized amount, or void the authorization and submit a new one."},
+	"49":   {Description: "The transaction amount submitted was greater than the maximum amount allowed.", Suggestion: "Split the amount or ask the merchant service provider to raise the limit."},
+	"50":   {Description: "This transaction is awaiting settlement and cannot be refunded.", Suggestion: "Void the transaction instead, or refund it after it has settled."},
+	"51":   {Description: "The sum of all credits against this transaction is greater than the original transaction amount.", Suggestion: "Refund at most the amount that has not been refunded yet."},
+	"52":   {Description: "The transaction was authorized but the client could not be notified; it will not be settled.", Suggestion: "The relay response or silent post URL was unreachable. Check the transaction status before charging again."},
+	"53":   {Description: "The transaction type is invalid for ACH transactions.", Suggestion: "eCheck.Net transactions cannot be authorized only or captured only."},
+	"54":   {Description: "The referenced transaction does not meet the criteria for issuing a credit.", Suggestion: "Only settled transactions can be refunded, within 120 days of settlement."},
+	"55":   {Description: "The sum of credits against the referenced transaction would exceed original debit amount.", Suggestion: "Refund at most the amount that has not been refunded yet."},
+	"56":   {Description: "Credit card transactions are not accepted by this merchant.", Suggestion: "The merchant account only accepts eCheck.Net transactions."},
+	"57":   {Description: "An error occurred in processing. Please try again in 5 minutes.", Suggestion: suggestionTryAgain, Retryable: true},
+	"58":   {Description: "An error occurred in processing. Please try again in 5 minutes.", Suggestion: suggestionTryAgain, Retryable: true},
+	"59":   {Description: "An error occurred in processing. Please try again in 5 minutes.", Suggestion: suggestionTryAgain, Retryable: true},
+	"60":   {Description: "An error occurred in processing. Please try again in 5 minutes.", Suggestion: suggestionTryAgain, Retryable: true},
+	"61":   {Description: "An error occurred in processing. Please try again in 5 minutes.", Suggestion: suggestionTryAgain, Retryable: true},
+	"62":   {Description: "An error occurred in processing. Please try again in 5 minutes.", Suggestion: suggestionTryAgain, Retryable: true},
+	"63":   {Description: "An error occurred in processing. Please try again in 5 minutes.", Suggestion: suggestionTryAgain, Retryable: true},
+	"65":   {Description: "This transaction has been declined.", Suggestion: "The card code did not match and the merchant's card code filter rejected it. Ask the customer to check the card code.", CustomerActionable: true},
+	"66":   {Description: "This transaction cannot be accepted for processing.", Suggestion: "The request does not meet the gateway security guidelines. Check the integration method and the required fields."},
+	"68":   {Description: "The version parameter is invalid.", Suggestion: suggestionCheckField},
+	"69":   {Description: "The transaction type is invalid.", Suggestion: "Use one of the TransactionType constants."},
+	"70":   {Description: "The transaction method is invalid.", Suggestion: suggestionCheckField},
+	"71":   {Description: "The bank account type is invalid.", Suggestion: "Use one of the BankAccountType constants.", CustomerActionable: true},
+	"72":   {Description: "The authorization code is invalid.", Suggestion: "The authCode must be 6 characters."},
+	"73":   {Description: "The driver's license date of birth is invalid.", CustomerActionable: true},
+	"74":   {Description: "The duty amount is invalid.", Suggestion: suggestionCheckField},
+	"75":   {Description: "The freight amount is invalid.", Suggestion: suggestionCheckField},
+	"76":   {Description: "The tax amount is invalid.", Suggestion: suggestionCheckField},
+	"77":   {Description: "The SSN or tax ID is invalid.", CustomerActionable: true},
+	"78":   {Description: "The card code is invalid.", Suggestion: "Ask the customer to check the 3 or 4 digit card code.", CustomerActionable: true},
+	"79":   {Description: "The driver's license number is invalid.", CustomerActionable: true},
+	"80":   {Description: "The driver's license state is invalid.", CustomerActionable: true},
+	"81":   {Description: "The requested form type is invalid."},
+	"82":   {Description: "Scripts are only supported in version 2.5."},
+	"83":   {Description: "The requested script is either invalid or no longer supported."},
+	"84":   {Description: "The device type is invalid or missing.", Suggestion: "Card present transactions require retail.deviceType."},
+	"85":   {Description: "The market type is invalid.", Suggestion: "Card present transactions require retail.marketType."},
+	"86":   {Description: "The Response Format is invalid."},
+	"87":   {Description: "Transactions of this market type cannot be processed on this system.", Suggestion: "The merchant account is not set up for the market type of the request."},
+	"88":   {Description: "Track1 data is not in a valid format.", CustomerActionable: true},
+	"89":   {Description: "Track2 data is not in a valid format.", CustomerActionable: true},
+	"90":   {Description: "ACH transactions cannot be accepted by this system."},
+	"91":   {Description: "Version 2.5 is no longer supported."},
+	"92":   {Description: "The gateway no longer supports the requested method of integration."},
+	"97":   {Description: "This transaction cannot be accepted.", Suggestion: "The fingerprint timestamp is too old or in the future. Check the server clock."},
+	"98":   {Description: "This transaction cannot be accepted.", Suggestion: "The fingerprint has already been used. Generate a new fingerprint for each request."},
+	"99":   {Description: "This transaction cannot be accepted.", Suggestion: suggestionFingerprint},
+	"100":  {Description: "The eCheck.Net type is invalid.", Suggestion: "Use one of the EcheckType constants."},
+	"101":  {Description: "The given name on the account and/or the account type does not match the actual account.", Suggestion: "Ask the customer to check the name on the bank account and its type.", CustomerActionable: true},
+	"102":  {Description: "This request cannot be accepted.", Suggestion: "A password was submitted along with a fingerprint. Remove the password."},
+	"103":  {Description: "This transaction cannot be accepted.", Suggestion: "A valid fingerprint, transaction key or password is required. " + suggestionCheckCredential},
+	"104":  {Description: "The transaction is currently under review.", Suggestion: "The eCheck.Net transaction is under review because of the customer's country."},
+	"105":  {Description: "The transaction is currently under review.", Suggestion: "The eCheck.Net transaction is under review because of the customer's city and country."},
+	"106":  {Description: "The transaction is currently under review.", Suggestion: "The eCheck.Net transaction is under review because of the company name."},
+	"107":  {Description: "The transaction is currently under review.", Suggestion: "The eCheck.Net transaction is under review because of the bank account name."},
+	"108":  {Description: "The transaction is currently under review.", Suggestion: "The eCheck.Net transaction is under review because of the customer's name."},
+	"109":  {Description: "The transaction is currently under review.", Suggestion: "The eCheck.Net transaction is under review because of the customer's name."},
+	"110":  {Description: "The transaction is currently under review.", Suggestion: "The eCheck.Net transaction is under review because of the bank account name."},
+	"116":  {Description: "The authentication indicator is invalid.", Suggestion: "Check the 3-D Secure authentication indicator (ECI)."},
+	"117":  {Description: "The cardholder authentication value is invalid.", Suggestion: "Check the 3-D Secure cardholder authentication value (CAVV)."},
+	"118":  {Description: "The combination of authentication indicator and cardholder authentication value is invalid."},
+	"119":  {Description: "Transactions having cardholder authentication values cannot be marked as recurring."},
+	"120":  {Description: "An error occurred during processing. Please try again.", Suggestion: suggestionStatusUnknown},
+	"121":  {Description: "An error occurred during processing. Please try again.", Suggestion: suggestionStatusUnknown},
+	"122":  {Description: "An error occurred during processing. Please try again.", Suggestion: suggestionStatusUnknown},
+	"123":  {Description: "This account has not been given the permission(s) required for this request.", Suggestion: "Grant the API user the permission in the Merchant Interface."},
+	"127":  {Description: "The transaction resulted in an AVS mismatch. The address provided does not match billing address of cardholder.", Suggestion: "The automatic void of the AVS mismatch failed. Void the transaction manually.", CustomerActionable: true},
+	"128":  {Description: "This transaction cannot be processed.", Suggestion: "The customer's bank rejected the eCheck.Net transaction. Ask the customer to use another payment method.", CustomerActionable: true},
+	"141":  {Description: "This transaction has been declined.", Suggestion: suggestionFraudFilter},
+	"145":  {Description: "This transaction has been declined.", Suggestion: suggestionFraudFilter},
+	"152":  {Description: "The transaction was authorized but the client could not be notified; it will not be settled.", Suggestion: "The relay response or silent post URL was unreachable. Check the transaction status before charging again."},
+	"153":  {Description: "There was an error processing the payment data.", Suggestion: "Check the encrypted payment data of the digital wallet."},
+	"165":  {Description: "This transaction has been declined.", Suggestion: suggestionFraudFilter},
+	"170":  {Description: "An error occurred during processing. Please contact the merchant.", Suggestion: suggestionMerchantSetup},
+	"171":  {Description: "An error occurred during processing. Please contact the merchant.", Suggestion: suggestionMerchantSetup},
+	"172":  {Description: "An error occurred during processing. Please contact the merchant.", Suggestion: suggestionMerchantSetup},
+	"173":  {Description: "An error occurred during processing. Please contact the merchant.", Suggestion: suggestionMerchantSetup},
+	"174":  {Description: "The transaction type is invalid. Please contact the merchant.", Suggestion: "The processor does not support the transaction type."},
+	"175":  {Description: "This processor does not allow voiding of credits.", Suggestion: "Submit a charge for the amount instead."},
+	"180":  {Description: "An error occurred during processing. Please try again.", Suggestion: suggestionStatusUnknown},
+	"181":  {Description: "An error occurred during processing. Please try again.", Suggestion: suggestionStatusUnknown},
+	"185":  {Description: "This reason code is reserved or not applicable to this API."},
+	"193":  {Description: "The transaction is currently under review.", Suggestion: suggestionHeldForReview},
+	"243":  {Description: "Recurring billing is not allowed for this eCheck.Net type."},
+	"244":  {Description: "This eCheck.Net type is not allowed for this Bank Account Type.", Suggestion: "CCD transactions require a business checking account, PPD and WEB a personal account."},
+	"245":  {Description: "This eCheck.Net type is not allowed when using the payment gateway hosted payment form."},
+	"246":  {Description: "This eCheck.Net type is not allowed.", Suggestion: "The merchant account is not enabled for the eCheck.Net type."},
+	"247":  {Description: "This eCheck.Net type is not allowed.", Suggestion: "The merchant account is not enabled for the eCheck.Net type."},
+	"248":  {Description: "The check number is invalid.", Suggestion: "ARC and BOC transactions require the check number.", CustomerActionable: true},
+	"250":  {Description: "This transaction has been declined.", Suggestion: "The customer's IP address is blocked by a fraud detection filter of the merchant."},
+	"251":  {Description: "This transaction has been declined.", Suggestion: suggestionFraudFilter},
+	"252":  {Description: "Your order has been received. Thank you for your business!", Suggestion: suggestionHeldForReview},
+	"253":  {Description: "Your order has been received. Thank you for your business!", Suggestion: suggestionHeldForReview},
+	"254":  {Description: "Your transaction has been declined.", Suggestion: "The merchant declined the transaction after review."},
+	"261":  {Description: "An error occurred during processing. Please try again.", Suggestion: suggestionStatusUnknown},
+	"270":  {Description: "A line item is invalid.", Suggestion: "The error text names the line item. Check its id, name, quantity and unit price."},
+	"271":  {Description: "The number of line items submitted is not allowed. A maximum of 30 line items can be submitted."},
+	"288":  {Description: "Merchant is not registered as a Cardholder Authentication participant.", Suggestion: "Remove the 3-D Secure values or enroll the merchant."},
+	"289":  {Description: "This processor does not accept zero dollar authorization for this card type.", Suggestion: "Authorize a small amount and void it instead."},
+	"290":  {Description: "There is one or more missing or invalid required fields.", Suggestion: "The processor requires the billing address and zip."},
+	"295":  {Description: "The amount of this request was only partially approved on the given prepaid card. An additional payment is required to fulfill the balance of this transaction.", Suggestion: "Collect the balance with another payment method using the splitTenderId.", CustomerActionable: true},
+	"296":  {Description: "The specified SplitTenderID is invalid."},
+	"297":  {Description: "Transaction ID and Split Tender ID cannot both be used in the same request."},
+	"298":  {Description: "This order has already been released or voided therefore new transaction associations cannot be added."},
+	"300":  {Description: "The device ID is invalid."},
+	"301":  {Description: "The device batch ID is invalid."},
+	"302":  {Description: "The reversal flag is invalid."},
+	"303":  {Description: "The device batch is full. Please close the batch."},
+	"304":  {Description: "The original transaction is in a closed batch."},
+	"305":  {Description: "The merchant is configured for auto-close."},
+	"306":  {Description: "The batch is already closed."},
+	"307":  {Description: "The reversal was processed successfully."},
+	"308":  {Description: "Original transaction for reversal not found."},
+	"309":  {Description: "The device has been disabled."},
+	"310":  {Description: "This transaction has already been voided."},
+	"311":  {Description: "This transaction has already been captured."},
+	"315":  {Description: "The credit card number is invalid.", Suggestion: "Ask the customer to check the card number.", CustomerActionable: true},
+	"316":  {Description: "The credit card expiration date is invalid.", CustomerActionable: true},
+	"317":  {Description: "The credit card has expired.", Suggestion: "Ask the customer for a card that has not expired.", CustomerActionable: true},
+	"318":  {Description: "A duplicate transaction has been submitted.", Suggestion: "Check whether the first transaction succeeded before submitting it again."},
+	"319":  {Description: "The transaction cannot be found."},
+	"320":  {Description: "The reversal feature is not supported."},
+	"325":  {Description: "The request data did not pass the required fields check for this application."},
+	"326":  {Description: "The request field(s) are either invalid or missing."},
+	"327":  {Description: "The void request failed. Either the original transaction type does not support void, or the transaction is in the process of being settled.", Suggestion: "Refund the transaction once it has settled."},
+	"328":  {Description: "A validation error occurred at the processor."},
+	"330":  {Description: "V.me transactions are not accepted by this merchant."},
+	"355":  {Description: "An error occurred while parsing the EMV data."},
+	"356":  {Description: "EMV-based transactions are not currently supported for this processor and card type."},
+	"357":  {Description: "Opaque Descriptor is required."},
+	"358":  {Description: "EMV data is not supported with this transaction type."},
+	"359":  {Description: "EMV data is not supported with this market type."},
+	"360":  {Description: "An error occurred while decrypting the EMV data."},
+	"361":  {Description: "The EMV version is invalid."},
+	"362":  {Description: "The EMV version is required."},
+	"363":  {Description: "The EMV CID is invalid."},
+	"370":  {Description: "Signature data is too large."},
+	"371":  {Description: "Signature must be PNG formatted data."},
+	"375":  {Description: "Terminal/lane number must be numeric."},
+	"380":  {Description: "KSN is duplicated."},
+	"901":  {Description: "This transaction cannot be accepted at this time due to system maintenance. Please try again later.", Suggestion: suggestionTryAgain, Retryable: true},
+	"2000": {Description: "Need payer consent.", Suggestion: "Redirect the customer to the PayPal secureAcceptanceUrl of the response."},
+	"2001": {Description: "PayPal transactions are not accepted by this merchant."},
+	"2003": {Description: "Request completed successfully."},
+	"2004": {Description: "Success URL is required.", Suggestion: "Set payPal.successUrl."},
+	"2005": {Description: "Cancel URL is required.", Suggestion: "Set payPal.cancelUrl."},
+	"2006": {Description: "Payer ID is required.", Suggestion: "Set payPal.payerID from the success URL of the PayPal redirect."},
+	"2007": {Description: "This processor does not accept zero dollar authorizations."},
+	"2008": {Description: "The original transaction is not in a capturable state."},
+	"2009": {Description: "The original transaction is not in a refundable state."},
+	"2010": {Description: "The original transaction is not in a voidable state."},
+	"2100": {Description: "PayPal transactions require valid URL for success_url."},
+	"2101": {Description: "PayPal transactions require valid URL for cancel_url."},
+	"2102": {Description: "Payment not authorized. Payment has not been authorized by the user.", CustomerActionable: true},
+	"2103": {Description: "This transaction has already been authorized."},
+	"2104": {Description: "The totals of the cart item amounts do not match order amounts. Be sure the total of the payment detail item parameters add up to the order total."},
+	"2105": {Description: "PayPal has rejected the transaction. Invalid Payer ID."},
+	"2106": {Description: "PayPal has already captured this transaction."},
+	"2107": {Description: "PayPal has rejected the transaction. Data provided is invalid."},
+}
+
+// messageCodes are the API message codes found in MessagesType.Message.
+var messageCodes = map[string]CodeInfo{
+	"I00001": {Description: "Successful."},
+	"I00003": {Description: "The record has already been deleted."},
+	"I00004": {Description: "No records found."},
+	"I00005": {Description: "The mobile device has been submitted for approval by the account administrator."},
+	"I00006": {Description: "The mobile device is approved and ready for use."},
+	"I00007": {Description: "The Payment Gateway Account service (id=8) has already been accepted."},
+	"I00008": {Description: "The Payment Gateway Account service (id=8) has already been declined."},
+	"I00009": {Description: "The APIUser already exists."},
+	"I00010": {Description: "The merchant is activated successfully."},
+	"I00011": {Description: "The merchant is not activated."},
+	"E00001": {Description: "An error occurred during processing. Please try again.", Suggestion: suggestionTryAgain, Retryable: true},
+	"E00002": {Description: "The content-type specified is not supported.", Suggestion: "Send the request with the text/xml content type."},
+	"E00003": {Description: "An error occurred while parsing the XML request.", Suggestion: "The error text names the element. Check the order of the elements and their values against the schema."},
+	"E00004": {Description: "The name of the requested API method is invalid."},
+	"E00005": {Description: "The transaction key or API key is invalid or not present.", Suggestion: suggestionCheckCredential},
+	"E00006": {Description: "The API user name is invalid or not present.", Suggestion: suggestionCheckCredential},
+	"E00007": {Description: "User authentication failed due to invalid authentication values.", Suggestion: suggestionCheckCredential + " Sandbox credentials only work against the sandbox host."},
+	"E00008": {Description: "User authentication failed. The account or API user is inactive.", Suggestion: "Reactivate the account or API user in the Merchant Interface."},
+	"E00009": {Description: "The payment gateway account is in Test Mode. The request cannot be processed.", Suggestion: "Turn off Test Mode in the Merchant Interface."},
+	"E00010": {Description: "User authentication failed. You do not have the appropriate permissions.", Suggestion: "Grant the API user the permission in the Merchant Interface."},
+	"E00011": {Description: "Access denied. You do not have the appropriate permissions.", Suggestion: "Enable the Transaction Details API in the Merchant Interface, or grant the API user the permission."},
+	"E00012": {Description: "A duplicate subscription already exists.", Suggestion: "A subscription with the same amount, schedule and payment details exists. Check the existing subscriptions."},
+	"E00013": {Description: "The field is invalid.", Suggestion: "The error text names the field. " + suggestionCheckField},
+	"E00014": {Description: "A required field is not present.", Suggestion: "The error text names the field. Add it to the request."},
+	"E00015": {Description: "The field length is invalid.", Suggestion: "The error text names the field. " + suggestionCheckField},
+	"E00016": {Description: "The field type is invalid.", Suggestion: "The error text names the field. " + suggestionCheckField},
+	"E00017": {Description: "The start date cannot occur in the past.", Suggestion: "Start dates are in Mountain Time; use today or a later date there."},
+	"E00018": {Description: "The credit card expires before the subscription start date.", Suggestion: "Ask the customer for a card that is valid at the start date.", CustomerActionable: true},
+	"E00019": {Description: "The customer tax id or drivers license information is required."},
+	"E00020": {Description: "The payment gateway account is not enabled for eCheck.Net subscriptions."},
+	"E00021": {Description: "The payment gateway account is not enabled for credit card subscriptions."},
+	"E00022": {Description: "The interval length cannot exceed 365 days or 12 months."},
+	"E00023": {Description: "The subscription duration cannot exceed three years."},
+	"E00024": {Description: "Trial Occurrences is required when Trial Amount is specified."},
+	"E00025": {Description: "Automated Recurring Billing is not enabled.", Suggestion: "Enable Automated Recurring Billing in the Merchant Interface."},
+	"E00026": {Description: "Both Trial Amount and Trial Occurrences are required."},
+	"E00027": {Description: "The transaction was unsuccessful.", Suggestion: "See the transaction errors for the reason."},
+	"E00028": {Description: "Trial Occurrences must be less than Total Occurrences."},
+	"E00029": {Description: "Payment information is required."},
+	"E00030": {Description: "The payment schedule is required."},
+	"E00031": {Description: "The amount is required."},
+	"E00032": {Description: "The start date is required."},
+	"E00033": {Description: "The start date cannot be changed.", Suggestion: "The start date can only be changed before the first payment."},
+	"E00034": {Description: "The interval information cannot be changed."},
+	"E00035": {Description: "The subscription cannot be found."},
+	"E00036": {Description: "The payment type cannot be changed.", Suggestion: "Cancel the subscription and create a new one with the other payment type."},
+	"E00037": {Description: "The subscription cannot be updated.", Suggestion: "Expired, canceled and terminated subscriptions cannot be updated."},
+	"E00038": {Description: "The subscription cannot be canceled.", Suggestion: "Expired and terminated subscriptions cannot be canceled."},
+	"E00039": {Description: "A duplicate record already exists.", Suggestion: "The error text holds the id of the existing record; use it instead of creating another."},
+	"E00040": {Description: "The record cannot be found.", Suggestion: "The profile or address id does not exist for this merchant account."},
+	"E00041": {Description: "One or more fields must contain a value.", Suggestion: "A customer profile requires a merchantCustomerId, description or email."},
+	"E00042": {Description: "You cannot add more than the maximum number of payment profiles.", Suggestion: "A customer profile holds at most 10 payment profiles."},
+	"E00043": {Description: "You cannot add more than the maximum number of shipping addresses.", Suggestion: "A customer profile holds at most 100 shipping addresses."},
+	"E00044": {Description: "Customer Information Manager is not enabled.", Suggestion: "Enable Customer Information Manager in the Merchant Interface."},
+	"E00045": {Description: "The root node does not reference a valid XML namespace."},
+	"E00046": {Description: "Generic InsertNewMerchant failure."},
+	"E00047": {Description: "Merchant Boarding API is not enabled."},
+	"E00048": {Description: "At least one payment method must be set in payment types or an echeck service must be provided."},
+	"E00049": {Description: "The operation timed out before it could be completed.", Suggestion: suggestionStatusUnknown},
+	"E00050": {Description: "Sell Rates cannot be less than Buy Rates."},
+	"E00051": {Description: "The original transaction was not issued for this payment profile."},
+	"E00052": {Description: "The maximum number of elements for an array has been exceeded."},
+	"E00053": {Description: "Server too busy.", Suggestion: suggestionTryAgain, Retryable: true},
+	"E00054": {Description: "The mobile device is not registered with this merchant account."},
+	"E00055": {Description: "The mobile device has already been registered but is pending approval by the account administrator."},
+	"E00056": {Description: "The mobile device has been disabled for use with this account."},
+	"E00057": {Description: "The user does not have permissions to submit requests from a mobile device."},
+	"E00058": {Description: "The merchant has met or exceeded the number of pending mobile devices permitted for this account."},
+	"E00059": {Description: "The authentication type is not allowed for this method call."},
+	"E00060": {Description: "The transaction type is invalid."},
+	"E00062": {Description: "Fatal error when calling web service."},
+	"E00063": {Description: "Calling web service return error."},
+	"E00064": {Description: "Client authorization denied."},
+	"E00065": {Description: "Prerequisite failed."},
+	"E00066": {Description: "Invalid value."},
+	"E00067": {Description: "An error occurred while parsing the XML request. Too many elements specified."},
+	"E00068": {Description: "An error occurred while parsing the XML request. An element is invalid."},
+	"E00069": {Description: "The Payment Gateway Account service (id=8) has already been accepted. Decline is not allowed."},
+	"E00070": {Description: "The Payment Gateway Account service (id=8) has already been declined. Agree is not allowed."},
+	"E00071": {Description: "An element must contain data."},
+	"E00072": {Description: "A required node is missing."},
+	"E00073": {Description: "An element is invalid."},
+	"E00074": {Description: "This merchant is not associated with this reseller."},
+	"E00075": {Description: "An error occurred while parsing the XML request. Missing field(s)."},
+	"E00076": {Description: "An element contains an invalid value."},
+	"E00077": {Description: "The value of an element is too long."},
+	"E00078": {Description: "Pending Status (not completed)."},
+	"E00079": {Description: "The impersonation login ID is invalid or not present."},
+	"E00080": {Description: "The impersonation API Key is invalid or not present."},
+	"E00083": {Description: "Bank payment method is not accepted for the selected business country."},
+	"E00084": {Description: "Credit card payment method is not accepted for the selected business country."},
+	"E00085": {Description: "The state is not valid."},
+	"E00086": {Description: "Merchant has declined authorization to resource."},
+	"E00087": {Description: "No subscriptions found for the given request."},
+	"E00088": {Description: "ProfileIds cannot be sent when requesting CreateProfile."},
+	"E00089": {Description: "Payment data is required when requesting CreateProfile."},
+	"E00090": {Description: "PaymentProfile cannot be sent with payment data."},
+	"E00091": {Description: "PaymentProfileId cannot be sent with payment data."},
+	"E00092": {Description: "ShippingProfileId cannot be sent with ShipTo data."},
+	"E00093": {Description: "PaymentProfile cannot be sent with billing data."},
+	"E00094": {Description: "Paging Offset exceeds the maximum allowed value.", Suggestion: "Request fewer pages, or narrow the search."},
+	"E00095": {Description: "ShippingProfileId is not provided within Customer Profile."},
+	"E00096": {Description: "Finger Print value is not valid.", Suggestion: suggestionFingerprint},
+	"E00097": {Description: "Finger Print can't be generated."},
+	"E00098": {Description: "Customer Profile ID or Shipping Profile ID not found."},
+	"E00099": {Description: "Customer profile creation failed. This transaction ID is invalid."},
+	"E00100": {Description: "Customer profile creation failed. This transaction type does not support profile creation."},
+	"E00101": {Description: "Customer profile creation failed."},
+	"E00102": {Description: "Customer Info is missing."},
+	"E00103": {Description: "Customer profile creation failed. This payment method does not support profile creation."},
+	"E00104": {Description: "Server in maintenance. Please try again later.", Suggestion: suggestionTryAgain, Retryable: true},
+	"E00105": {Description: "The specified payment profile is associated with an active or suspended subscription and cannot be deleted.", Suggestion: "Cancel the subscription first."},
+	"E00106": {Description: "The specified customer profile is associated with an active or suspended subscription and cannot be deleted.", Suggestion: "Cancel the subscription first."},
+	"E00107": {Description: "The specified shipping profile is associated with an active or suspended subscription and cannot be deleted.", Suggestion: "Cancel the subscription first."},
+	"E00108": {Description: "CustomerProfileId cannot be sent with customer data."},
+	"E00109": {Description: "CustomerAddressId cannot be sent with shipTo data."},
+	"E00110": {Description: "CustomerPaymentProfileId is not provided within Customer Profile."},
+	"E00111": {Description: "The original subscription was not created with this Customer Profile."},
+	"E00112": {Description: "The specified month should not be in the future."},
+	"E00113": {Description: "Invalid OTS Token Data.", Suggestion: "The Accept.js payment nonce is malformed."},
+	"E00114": {Description: "Invalid OTS Token.", Suggestion: "The Accept.js payment nonce is invalid or was already used.", CustomerActionable: true},
+	"E00115": {Description: "Expired OTS Token.", Suggestion: "Accept.js payment nonces expire after 15 minutes. Ask the customer to submit the payment form again.", CustomerActionable: true},
+	"E00116": {Description: "OTS Token access violation.", Suggestion: "The Accept.js payment nonce was created with the credentials of another merchant."},
+	"E00117": {Description: "OTS Service Error."},
+	"E00118": {Description: "The transaction has been declined.", Suggestion: suggestionOtherPayment, CustomerActionable: true},
+	"E00119": {Description: "Payment information should not be sent to Hosted Payment Page request."},
+	"E00120": {Description: "Payment and Shipping Profile IDs cannot be specified when creating new profiles."},
+	"E00121": {Description: "No default payment/shipping profile found."},
+	"E00122": {Description: "Please use Merchant Interface settings (API Credentials and Keys) to generate a signature key."},
+	"E00123": {Description: "The provided access token has expired."},
+	"E00124": {Description: "The provided access token is invalid."},
+	"E00125": {Description: "Hash doesn't match."},
+	"E00126": {Description: "Failed shared key validation."},
+	"E00127": {Description: "Invoice does not exist."},
+	"E00128": {Description: "Requested action is not allowed."},
+	"E00129": {Description: "Failed sending email."},
+	"E00130": {Description: "Valid Customer Profile ID or Email is required."},
+	"E00131": {Description: "Invoice created but not processed completely."},
+	"E00132": {Description: "Invoicing or CIM service is not enabled."},
+	"E00133": {Description: "Server error."},
+	"E00134": {Description: "Due date is invalid."},
+	"E00135": {Description: "Merchant has not provided processor information."},
+	"E00136": {Description: "Processor account is still in process, please try again later."},
+	"E00137": {Description: "Multiple payment types are not allowed."},
+	"E00138": {Description: "Payment and Shipping Profile IDs cannot be specified when requesting a hosted payment page."},
+	"E00139": {Description: "The Access token does not have permission to call the API method."},
+	"E00140": {Description: "Reference Id not found."},
+	"E00142": {Description: "RecurringBilling setting is a required field for recurring tokenized payment transactions."},
+	"E00144": {Description: "We are currently holding the last transaction for review. Before you reactivate the subscription, review the transaction.", Suggestion: suggestionHeldForReview},
+	"E00145": {Description: "This invoice has been canceled by the sender. Please contact the sender directly if you have questions."},
+}
+
+// LookupReasonCode returns the catalogue entry of a transaction response reason code.
+func LookupReasonCode(code string) (CodeInfo, bool) {
+	info, ok := reasonCodes[code]
+	info.Code = code
+	return info, ok
+}
+
+// LookupMessageCode returns the catalogue entry of an API message code, such as E00027. Transaction messages carry
+// reason codes rather than message codes, so numeric codes are looked up with LookupReasonCode.
+func LookupMessageCode(code string) (CodeInfo, bool) {
+	if len(code) > 0 && !strings.HasPrefix(code, "E") && !strings.HasPrefix(code, "I") {
+		return LookupReasonCode(code)
+	}
+	info, ok := messageCodes[code]
+	info.Code = code
+	return info, ok
+}
+
+// Info returns the catalogue entry of the error code.
+func (e Error) Info() (CodeInfo, bool) {
+	return LookupReasonCode(e.ErrorCode)
+}
+
+// Info returns the catalogue entry of the message code.
+func (m Message) Info() (CodeInfo, bool) {
+	return LookupMessageCode(m.Code)
+}
+
+// Info returns the catalogue entry of the message code.
+func (e *APIError) Info() (CodeInfo, bool) {
+	return LookupMessageCode(e.Code)
+}
+
+// Info returns the catalogue entry of the reason code.
+func (e *TransactionError) Info() (CodeInfo, bool) {
+	return LookupReasonCode(e.Code)
+}
+
+// Infos returns the catalogue entries of the messages and transaction errors, in that order. Codes missing from the
+// catalogue are described by the text the gateway returned.
+func (e *RequestError) Infos() []CodeInfo {
+	var infos []CodeInfo
+	for _, message := range e.Codes() {
+		info, ok := message.Info()
+		if !ok {
+			info.Description = message.Text
+		}
+		infos = append(infos, info)
+	}
+	for _, transactionError := range e.TransactionErrors {
+		info, ok := transactionError.Info()
+		if !ok {
+			info.Description = transactionError.ErrorText
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
@@ -0,0 +1,130 @@
+package authnet
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestLookupReasonCode(t *testing.T) {
+	cases := []struct {
+		code       string
+		found      bool
+		actionable bool
+		retryable  bool
+	}{
+		{"2", true, true, false},
+		{"6", true, true, false},
+		{"11", true, false, false},
+		{"19", true, false, true},
+		{"57", true, false, true},
+		{"901", true, false, true},
+		{"99999", false, false, false},
+		{"", false, false, false},
+		{"E00001", false, false, false},
+	}
+	for _, c := range cases {
+		info, found := LookupReasonCode(c.code)
+		if found != c.found || info.Code != c.code || info.CustomerActionable != c.actionable || info.Retryable != c.retryable {
+			t.Errorf("%q: expected found %t, actionable %t and retryable %t, got %t %+v", c.code, c.found, c.actionable,
+				c.retryable, found, info)
+		}
+		if found && len(info.Description) == 0 {
+			t.Errorf("%q: missing description", c.code)
+		}
+	}
+}
+
+func TestLookupMessageCode(t *testing.T) {
+	cases := []struct {
+		code      string
+		found     bool
+		retryable bool
+	}{
+		{"E00001", true, true},
+		{"E00007", true, false},
+		{"E00027", true, false},
+		{"I00001", true, false},
+		{"E99999", false, false},
+		// Numeric codes are transaction reason codes.
+		{"19", true, true},
+		{"", false, false},
+	}
+	for _, c := range cases {
+		info, found := LookupMessageCode(c.code)
+		if found != c.found || info.Code != c.code || info.Retryable != c.retryable {
+			t.Errorf("%q: expected found %t and retryable %t, got %t %+v", c.code, c.found, c.retryable, found, info)
+		}
+	}
+}
+
+// TestRetryableMessageCodes pins the message codes the client retries, see hasTransientMessage.
+func TestRetryableMessageCodes(t *testing.T) {
+	var retryable []string
+	for code, info := range messageCodes {
+		if info.Retryable {
+			retryable = append(retryable, code)
+		}
+	}
+	sort.Strings(retryable)
+	if expected := []string{"E00001", "E00053", "E00104"}; !reflect.DeepEqual(retryable, expected) {
+		t.Errorf("expected retryable message codes %v, got %v", expected, retryable)
+	}
+	for code, info := range reasonCodes {
+		if info.Retryable && info.CustomerActionable {
+			t.Errorf("reason code %s is both retryable and customer actionable", code)
+		}
+	}
+	if info, _ := LookupReasonCode("11"); info.Retryable {
+		t.Errorf("the duplicate transaction reason code must not be retryable")
+	}
+}
+
+func TestCodeInfoMethods(t *testing.T) {
+	if info, ok := (Error{ErrorCode: "2"}).Info(); !ok || !info.CustomerActionable {
+		t.Errorf("Error: unexpected %+v", info)
+	}
+	if info, ok := (Message{Code: "E00053"}).Info(); !ok || !info.Retryable {
+		t.Errorf("Message: unexpected %+v", info)
+	}
+	if info, ok := ErrRecordNotFound.Info(); !ok || info.Code != "E00040" {
+		t.Errorf("APIError: unexpected %+v", info)
+	}
+	if info, ok := ErrDuplicateTransaction.Info(); !ok || info.Code != "11" {
+		t.Errorf("TransactionError: unexpected %+v", info)
+	}
+}
+
+func TestRequestErrorInfos(t *testing.T) {
+	reqErr := &RequestError{
+		Response: &ErrorResponse{Messages: Messages{
+			ResultCode: MessageTypeError,
+			Message: []Message{
+				{Code: "E00027", Text: "The transaction was unsuccessful."},
+				{Code: "E99999", Text: "Something new."},
+			},
+		}},
+		TransactionErrors: []Error{
+			{ErrorCode: "2", ErrorText: "This transaction has been declined."},
+			{ErrorCode: "99999", ErrorText: "Another new code."},
+		},
+	}
+	infos := reqErr.Infos()
+	var codes, descriptions []string
+	for _, info := range infos {
+		codes = append(codes, info.Code)
+		descriptions = append(descriptions, info.Description)
+	}
+	if expected := []string{"E00027", "E99999", "2", "99999"}; !reflect.DeepEqual(codes, expected) {
+		t.Fatalf("expected codes %v, got %v", expected, codes)
+	}
+	if descriptions[1] != "Something new." || descriptions[3] != "Another new code." {
+		t.Errorf("expected unknown codes to be described by the gateway text, got %v", descriptions)
+	}
+	if !infos[2].CustomerActionable {
+		t.Errorf("expected reason code 2 to be customer actionable")
+	}
+	if infos := (&RequestError{Err: &TransportError{}}).Infos(); len(infos) != 0 {
+		t.Errorf("expected no infos for a transport error, got %v", infos)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 )
 
@@ -12,12 +13,20 @@ const (
 	EnvGoGoAuthnetConfig = "GOGO_AUTHNET_CONFIG"
 )
 
+// DefaultMaxResponseBodySize is the largest response body read when Config.MaxResponseBodySize is not set.
+const DefaultMaxResponseBodySize = 10 << 20
+
 // Config is the configuration for the API. Refer to the [config documentation] for key references.
 //
 // [config documentation]: https://github.com/BigBallard/gogo-authnet/blob/master/docs/CONFIG.md
 type Config struct {
 	AuthnetHost string `json:"authnet-host,omitempty"`
 	Auth        *Auth  `json:"auth,omitempty"` // API authorization credentials
+	// ValidateRequests runs Validate on every request before it is sent.
+	ValidateRequests bool `json:"validate-requests,omitempty"`
+	// MaxResponseBodySize is the largest response body in bytes that is read, DefaultMaxResponseBodySize when not set.
+	MaxResponseBodySize int64  `json:"max-response-body-size,omitempty"`
+	Retry               *Retry `json:"retry,omitempty"` // Retry policy for failed requests
 }
 
 // Auth provides API authorization credentials
@@ -26,6 +35,26 @@ type Auth struct {
 	TransactionKey string `json:"transaction-key,omitempty"`
 }
 
+// Retry configures how failed requests are retried. Requests are only retried when repeating them cannot cause a
+// duplicate operation:
+//   - the connection to the gateway could not be established, so the request was never written.
+//   - the gateway responded with an HTTP 5xx status to a request that only reads data.
+//   - the gateway responded with a result code it documents as temporary, such as E00001. See CodeInfo.Retryable.
+//
+// A createTransactionRequest is only retried for the last two reasons, or after the connection failed while the
+// request was in flight, when it has an invoice number and the gateway duplicate window is not disabled through the
+// duplicateWindow transaction setting. A repeated transaction is then rejected by the gateway as a duplicate instead of
+// charging the customer twice. The refId is not part of the duplicate check and is not enough. Transactions declined
+// or failed with a reason code are never retried, as the gateway rejects them as duplicates within the window.
+type Retry struct {
+	// MaxAttempts is the total number of attempts including the first, 1 when not set.
+	MaxAttempts int `json:"max-attempts,omitempty"`
+	// InitialBackoffMs is the delay before the first retry in milliseconds, doubled for every further retry.
+	InitialBackoffMs int `json:"initial-backoff-ms,omitempty"`
+	// MaxBackoffMs caps the delay between retries in milliseconds.
+	MaxBackoffMs int `json:"max-backoff-ms,omitempty"`
+}
+
 // aggregate applies the configuration values set through the CLI and the environment variables.
 func aggregate(config *Config) {
 	arguments := os.Args[1:]
@@ -36,7 +65,7 @@ func aggregate(config *Config) {
 		if !strings.HasPrefix(arg, "-") {
 			continue
 		}
-		if i+1 > argLen {
+		if i+1 >= argLen {
 			break
 		}
 		value := arguments[i+1]
@@ -54,6 +83,20 @@ func aggregate(config *Config) {
 				config.Auth = new(Auth)
 			}
 			config.Auth.TransactionKey = value
+		case "-VALIDATE_REQUESTS":
+			if parsed, pErr := strconv.ParseBool(value); pErr == nil {
+				config.ValidateRequests = parsed
+			}
+		case "-MAX_RESPONSE_BODY_SIZE":
+			if parsed, pErr := strconv.ParseInt(value, 10, 64); pErr == nil {
+				config.MaxResponseBodySize = parsed
+			}
+		case "-RETRY_MAX_ATTEMPTS":
+			aggregateRetryInt(config, value, func(retry *Retry, v int) { retry.MaxAttempts = v })
+		case "-RETRY_INITIAL_BACKOFF_MS":
+			aggregateRetryInt(config, value, func(retry *Retry, v int) { retry.InitialBackoffMs = v })
+		case "-RETRY_MAX_BACKOFF_MS":
+			aggregateRetryInt(config, value, func(retry *Retry, v int) { retry.MaxBackoffMs = v })
 		}
 	}
 
@@ -72,6 +115,37 @@ func aggregate(config *Config) {
 		}
 		config.Auth.TransactionKey = value
 	}
+	if value, ok := os.LookupEnv("VALIDATE_REQUESTS"); ok {
+		if parsed, pErr := strconv.ParseBool(value); pErr == nil {
+			config.ValidateRequests = parsed
+		}
+	}
+	if value, ok := os.LookupEnv("MAX_RESPONSE_BODY_SIZE"); ok {
+		if parsed, pErr := strconv.ParseInt(value, 10, 64); pErr == nil {
+			config.MaxResponseBodySize = parsed
+		}
+	}
+	if value, ok := os.LookupEnv("RETRY_MAX_ATTEMPTS"); ok {
+		aggregateRetryInt(config, value, func(retry *Retry, v int) { retry.MaxAttempts = v })
+	}
+	if value, ok := os.LookupEnv("RETRY_INITIAL_BACKOFF_MS"); ok {
+		aggregateRetryInt(config, value, func(retry *Retry, v int) { retry.InitialBackoffMs = v })
+	}
+	if value, ok := os.LookupEnv("RETRY_MAX_BACKOFF_MS"); ok {
+		aggregateRetryInt(config, value, func(retry *Retry, v int) { retry.MaxBackoffMs = v })
+	}
+}
+
+// aggregateRetryInt parses value and applies it to the retry config, creating it when needed.
+func aggregateRetryInt(config *Config, value string, apply func(retry *Retry, v int)) {
+	parsed, pErr := strconv.Atoi(value)
+	if pErr != nil {
+		return
+	}
+	if config.Retry == nil {
+		config.Retry = new(Retry)
+	}
+	apply(config.Retry, parsed)
 }
 
 // LoadConfigFromFile attempts to load the config JSON file from the path provided. Aggregate determines if the config will
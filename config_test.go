@@ -0,0 +1,18 @@
+package authnet
+
+import (
+	"os"
+	"testing"
+)
+
+func TestAggregateIgnoresFlagWithoutValue(t *testing.T) {
+	args := os.Args
+	t.Cleanup(func() { os.Args = args })
+	os.Args = []string{"app", "-AUTHNET_HOST", "https://apitest.authorize.net", "-VALIDATE_REQUESTS"}
+
+	var config Config
+	aggregate(&config)
+	if config.AuthnetHost != "https://apitest.authorize.net" || config.ValidateRequests {
+		t.Errorf("unexpected config %+v", config)
+	}
+}
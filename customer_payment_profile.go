@@ -0,0 +1,215 @@
+package authnet
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+type CreateCustomerPaymentProfileRequest struct {
+	ANetApiRequest
+	XMLName           xml.Name                   `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd createCustomerPaymentProfileRequest"`
+	CustomerProfileId string                     `xml:"customerProfileId" validation:"required,numeric"`
+	PaymentProfile    CustomerPaymentProfileType `xml:"paymentProfile" validation:"required"`
+	ValidationMode    ValidationModeEnum         `xml:"validationMode,omitempty"`
+}
+
+type CreateCustomerPaymentProfileResponse struct {
+	ANetApiResponse
+	XMLName                  xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd createCustomerPaymentProfileResponse"`
+	CustomerProfileId        string   `xml:"customerProfileId,omitempty"`
+	CustomerPaymentProfileId string   `xml:"customerPaymentProfileId,omitempty"`
+	ValidationDirectResponse string   `xml:"validationDirectResponse,omitempty"`
+}
+
+type GetCustomerPaymentProfileRequest struct {
+	ANetApiRequest
+	XMLName                  xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getCustomerPaymentProfileRequest"`
+	CustomerProfileId        string   `xml:"customerProfileId" validation:"required,numeric"`
+	CustomerPaymentProfileId string   `xml:"customerPaymentProfileId" validation:"required,numeric"`
+	UnmaskExpirationDate     *bool    `xml:"unmaskExpirationDate,omitempty"`
+	IncludeIssuerInfo        *bool    `xml:"includeIssuerInfo,omitempty"`
+}
+
+type GetCustomerPaymentProfileResponse struct {
+	ANetApiResponse
+	XMLName        xml.Name                          `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getCustomerPaymentProfileResponse"`
+	PaymentProfile *CustomerPaymentProfileMaskedType `xml:"paymentProfile,omitempty"`
+}
+
+type UpdateCustomerPaymentProfileRequest struct {
+	ANetApiRequest
+	XMLName           xml.Name                     `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd updateCustomerPaymentProfileRequest"`
+	CustomerProfileId string                       `xml:"customerProfileId" validation:"required,numeric"`
+	PaymentProfile    CustomerPaymentProfileExType `xml:"paymentProfile" validation:"required"`
+	ValidationMode    ValidationModeEnum           `xml:"validationMode,omitempty"`
+}
+
+type UpdateCustomerPaymentProfileResponse struct {
+	ANetApiResponse
+	XMLName                  xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd updateCustomerPaymentProfileResponse"`
+	ValidationDirectResponse string   `xml:"validationDirectResponse,omitempty"`
+}
+
+type DeleteCustomerPaymentProfileRequest struct {
+	ANetApiRequest
+	XMLName                  xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd deleteCustomerPaymentProfileRequest"`
+	CustomerProfileId        string   `xml:"customerProfileId" validation:"required,numeric"`
+	CustomerPaymentProfileId string   `xml:"customerPaymentProfileId" validation:"required,numeric"`
+}
+
+type DeleteCustomerPaymentProfileResponse struct {
+	ANetApiResponse
+	XMLName xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd deleteCustomerPaymentProfileResponse"`
+}
+
+type ValidateCustomerPaymentProfileRequest struct {
+	ANetApiRequest
+	XMLName                   xml.Name           `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd validateCustomerPaymentProfileRequest"`
+	CustomerProfileId         string             `xml:"customerProfileId" validation:"required,numeric"`
+	CustomerPaymentProfileId  string             `xml:"customerPaymentProfileId" validation:"required,numeric"`
+	CustomerShippingAddressId string             `xml:"customerShippingAddressId,omitempty" validation:"numeric"`
+	CardCode                  string             `xml:"cardCode,omitempty" validation:"numeric,min=3,max=4"`
+	ValidationMode            ValidationModeEnum `xml:"validationMode" validation:"required,oneOf=testMode liveMode"`
+}
+
+type ValidateCustomerPaymentProfileResponse struct {
+	ANetApiResponse
+	XMLName        xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd validateCustomerPaymentProfileResponse"`
+	DirectResponse string   `xml:"directResponse,omitempty"`
+}
+
+type CustomerPaymentProfileSearchTypeEnum = string
+
+const (
+	CustomerPaymentProfileSearchTypeCardsExpiringInMonth CustomerPaymentProfileSearchTypeEnum = "cardsExpiringInMonth"
+)
+
+type CustomerPaymentProfileOrderFieldEnum = string
+
+const (
+	CustomerPaymentProfileOrderFieldId CustomerPaymentProfileOrderFieldEnum = "id"
+)
+
+type CustomerPaymentProfileSorting struct {
+	OrderBy         CustomerPaymentProfileOrderFieldEnum `xml:"orderBy" validation:"required,oneOf=id"`
+	OrderDescending bool                                 `xml:"orderDescending"`
+}
+
+type GetCustomerPaymentProfileListRequest struct {
+	ANetApiRequest
+	XMLName    xml.Name                             `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getCustomerPaymentProfileListRequest"`
+	SearchType CustomerPaymentProfileSearchTypeEnum `xml:"searchType" validation:"required,oneOf=cardsExpiringInMonth"`
+	// Month format should be gYearMonth (such as 2001-10).
+	Month   string                         `xml:"month" validation:"required,min=7,max=7"`
+	Sorting *CustomerPaymentProfileSorting `xml:"sorting,omitempty"`
+	Paging  *Paging                        `xml:"paging,omitempty"`
+}
+
+type CustomerPaymentProfileListItemType struct {
+	DefaultPaymentProfile     *bool                `xml:"defaultPaymentProfile,omitempty"`
+	CustomerPaymentProfileId  string               `xml:"customerPaymentProfileId"`
+	CustomerProfileId         string               `xml:"customerProfileId"`
+	BillTo                    *CustomerAddressType `xml:"billTo,omitempty"`
+	Payment                   *PaymentMaskedType   `xml:"payment,omitempty"`
+	OriginalNetworkTransId    string               `xml:"originalNetworkTransId,omitempty"`
+	OriginalAuthAmount        *Amount              `xml:"originalAuthAmount,omitempty"`
+	ExcludeFromAccountUpdater *bool                `xml:"excludeFromAccountUpdater,omitempty"`
+}
+
+type ArrayOfCustomerPaymentProfileListItemType struct {
+	PaymentProfile []CustomerPaymentProfileListItemType `xml:"paymentProfile,omitempty"`
+}
+
+type GetCustomerPaymentProfileListResponse struct {
+	ANetApiResponse
+	XMLName             xml.Name                                   `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getCustomerPaymentProfileListResponse"`
+	TotalNumInResultSet int                                        `xml:"totalNumInResultSet"`
+	PaymentProfiles     *ArrayOfCustomerPaymentProfileListItemType `xml:"paymentProfiles,omitempty"`
+}
+
+// CreateCustomerPaymentProfile adds a new payment profile to an existing customer profile.
+func (c *AuthNetClient) CreateCustomerPaymentProfile(req CreateCustomerPaymentProfileRequest) (*CreateCustomerPaymentProfileResponse, error) {
+	return c.CreateCustomerPaymentProfileContext(context.Background(), req)
+}
+
+// CreateCustomerPaymentProfileContext is like CreateCustomerPaymentProfile but uses ctx for the request.
+func (c *AuthNetClient) CreateCustomerPaymentProfileContext(ctx context.Context, req CreateCustomerPaymentProfileRequest) (*CreateCustomerPaymentProfileResponse, error) {
+	var res CreateCustomerPaymentProfileResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetCustomerPaymentProfile retrieves a single payment profile of an existing customer profile.
+func (c *AuthNetClient) GetCustomerPaymentProfile(req GetCustomerPaymentProfileRequest) (*GetCustomerPaymentProfileResponse, error) {
+	return c.GetCustomerPaymentProfileContext(context.Background(), req)
+}
+
+// GetCustomerPaymentProfileContext is like GetCustomerPaymentProfile but uses ctx for the request.
+func (c *AuthNetClient) GetCustomerPaymentProfileContext(ctx context.Context, req GetCustomerPaymentProfileRequest) (*GetCustomerPaymentProfileResponse, error) {
+	var res GetCustomerPaymentProfileResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// UpdateCustomerPaymentProfile updates a payment profile of an existing customer profile. The masked card and bank
+// account numbers returned by GetCustomerPaymentProfile may be sent back unchanged, and pass Validate, to leave the
+// stored values as they are.
+func (c *AuthNetClient) UpdateCustomerPaymentProfile(req UpdateCustomerPaymentProfileRequest) (*UpdateCustomerPaymentProfileResponse, error) {
+	return c.UpdateCustomerPaymentProfileContext(context.Background(), req)
+}
+
+// UpdateCustomerPaymentProfileContext is like UpdateCustomerPaymentProfile but uses ctx for the request.
+func (c *AuthNetClient) UpdateCustomerPaymentProfileContext(ctx context.Context, req UpdateCustomerPaymentProfileRequest) (*UpdateCustomerPaymentProfileResponse, error) {
+	var res UpdateCustomerPaymentProfileResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// DeleteCustomerPaymentProfile deletes a payment profile from an existing customer profile.
+func (c *AuthNetClient) DeleteCustomerPaymentProfile(req DeleteCustomerPaymentProfileRequest) (*DeleteCustomerPaymentProfileResponse, error) {
+	return c.DeleteCustomerPaymentProfileContext(context.Background(), req)
+}
+
+// DeleteCustomerPaymentProfileContext is like DeleteCustomerPaymentProfile but uses ctx for the request.
+func (c *AuthNetClient) DeleteCustomerPaymentProfileContext(ctx context.Context, req DeleteCustomerPaymentProfileRequest) (*DeleteCustomerPaymentProfileResponse, error) {
+	var res DeleteCustomerPaymentProfileResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ValidateCustomerPaymentProfile generates a test transaction against a stored payment profile to verify it.
+func (c *AuthNetClient) ValidateCustomerPaymentProfile(req ValidateCustomerPaymentProfileRequest) (*ValidateCustomerPaymentProfileResponse, error) {
+	return c.ValidateCustomerPaymentProfileContext(context.Background(), req)
+}
+
+// ValidateCustomerPaymentProfileContext is like ValidateCustomerPaymentProfile but uses ctx for the request.
+func (c *AuthNetClient) ValidateCustomerPaymentProfileContext(ctx context.Context, req ValidateCustomerPaymentProfileRequest) (*ValidateCustomerPaymentProfileResponse, error) {
+	var res ValidateCustomerPaymentProfileResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetCustomerPaymentProfileList searches the payment profiles of every customer profile, such as the cards expiring in
+// a given month.
+func (c *AuthNetClient) GetCustomerPaymentProfileList(req GetCustomerPaymentProfileListRequest) (*GetCustomerPaymentProfileListResponse, error) {
+	return c.GetCustomerPaymentProfileListContext(context.Background(), req)
+}
+
+// GetCustomerPaymentProfileListContext is like GetCustomerPaymentProfileList but uses ctx for the request.
+func (c *AuthNetClient) GetCustomerPaymentProfileListContext(ctx context.Context, req GetCustomerPaymentProfileListRequest) (*GetCustomerPaymentProfileListResponse, error) {
+	var res GetCustomerPaymentProfileListResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,201 @@
+package authnet
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCustomerPaymentProfileOperations(t *testing.T) {
+	runOperationCases(t, []operationCase{
+		{
+			name: "create",
+			response: `<customerProfileId>10</customerProfileId><customerPaymentProfileId>20</customerPaymentProfileId>` +
+				`<validationDirectResponse>1,1,1,This transaction has been approved.</validationDirectResponse>`,
+			call: func(t *testing.T, c *AuthNetClient) {
+				res, err := c.CreateCustomerPaymentProfile(CreateCustomerPaymentProfileRequest{
+					ANetApiRequest:    ANetApiRequest{MerchantAuthentication: testAuth},
+					CustomerProfileId: "10",
+					PaymentProfile: CustomerPaymentProfileType{
+						CustomerPaymentProfileBaseType: CustomerPaymentProfileBaseType{
+							BillTo: &CustomerAddressType{NameAndAddressType: NameAndAddressType{FirstName: "Ada", LastName: "Lovelace"}},
+						},
+						Payment: &PaymentType{CreditCard: &CreditCardType{
+							CreditCardSimpleType: CreditCardSimpleType{CardNumber: "4111111111111111", ExpirationDate: "2030-12"},
+						}},
+					},
+					ValidationMode: ValidationModeLiveMode,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if res.CustomerProfileId != "10" || res.CustomerPaymentProfileId != "20" || len(res.ValidationDirectResponse) == 0 {
+					t.Errorf("unexpected response %+v", res)
+				}
+			},
+			request: []string{
+				`<createCustomerPaymentProfileRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`</merchantAuthentication><customerProfileId>10</customerProfileId>`,
+				`<paymentProfile><billTo><firstName>Ada</firstName><lastName>Lovelace</lastName></billTo>`,
+				`<payment><creditCard><cardNumber>4111111111111111</cardNumber>`,
+				`</paymentProfile><validationMode>liveMode</validationMode>`,
+			},
+		},
+		{
+			name: "get",
+			response: `<paymentProfile><customerType>individual</customerType><customerProfileId>10</customerProfileId>` +
+				`<customerPaymentProfileId>20</customerPaymentProfileId>` +
+				`<payment><bankAccount><accountType>checking</accountType><routingNumber>XXXX0021</routingNumber>` +
+				`<accountNumber>XXXX6789</accountNumber><nameOnAccount>John Doe</nameOnAccount><echeckType>WEB</echeckType>` +
+				`</bankAccount></payment>` +
+				`<subscriptionIds><subscriptionId>40</subscriptionId></subscriptionIds></paymentProfile>`,
+			call: func(t *testing.T, c *AuthNetClient) {
+				res, err := c.GetCustomerPaymentProfile(GetCustomerPaymentProfileRequest{
+					ANetApiRequest:           ANetApiRequest{MerchantAuthentication: testAuth},
+					CustomerProfileId:        "10",
+					CustomerPaymentProfileId: "20",
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				profile := res.PaymentProfile
+				if profile == nil || profile.CustomerPaymentProfileId != "20" || profile.CustomerType == nil ||
+					*profile.CustomerType != CustomerTypeIndividual {
+					t.Fatalf("unexpected payment profile %+v", profile)
+				}
+				if bankAccount := profile.Payment.BankAccount; bankAccount == nil || bankAccount.RoutingNumber != "XXXX0021" ||
+					bankAccount.AccountNumber != "XXXX6789" {
+					t.Errorf("unexpected bank account %+v", profile.Payment.BankAccount)
+				}
+				if profile.SubscriptionIds == nil || len(profile.SubscriptionIds.SubscriptionId) != 1 {
+					t.Errorf("unexpected subscription ids %+v", profile.SubscriptionIds)
+				}
+			},
+			request: []string{
+				`<getCustomerPaymentProfileRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`<customerProfileId>10</customerProfileId><customerPaymentProfileId>20</customerPaymentProfileId>`,
+			},
+		},
+		{
+			name:     "update with masked bank account",
+			response: `<validationDirectResponse>1,1,1,This transaction has been approved.</validationDirectResponse>`,
+			call: func(t *testing.T, c *AuthNetClient) {
+				c.config.ValidateRequests = true
+				res, err := c.UpdateCustomerPaymentProfile(UpdateCustomerPaymentProfileRequest{
+					ANetApiRequest:    ANetApiRequest{MerchantAuthentication: testAuth},
+					CustomerProfileId: "10",
+					PaymentProfile: CustomerPaymentProfileExType{
+						CustomerPaymentProfileType: CustomerPaymentProfileType{
+							Payment: &PaymentType{BankAccount: &BankAccountType{
+								AccountType:   AccountTypeChecking,
+								RoutingNumber: "XXXX0021",
+								AccountNumber: "XXXX6789",
+								NameOnAccount: "John Doe",
+								EcheckType:    EcheckTypeWEB,
+							}},
+						},
+						CustomerPaymentProfileId: "20",
+					},
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(res.ValidationDirectResponse) == 0 {
+					t.Errorf("unexpected response %+v", res)
+				}
+			},
+			request: []string{
+				`<updateCustomerPaymentProfileRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`<customerProfileId>10</customerProfileId><paymentProfile><payment><bankAccount>`,
+				`<routingNumber>XXXX0021</routingNumber><accountNumber>XXXX6789</accountNumber>`,
+				`</payment><customerPaymentProfileId>20</customerPaymentProfileId></paymentProfile>`,
+			},
+		},
+		{
+			name: "update with masked card",
+			call: func(t *testing.T, c *AuthNetClient) {
+				c.config.ValidateRequests = true
+				if _, err := c.UpdateCustomerPaymentProfile(UpdateCustomerPaymentProfileRequest{
+					ANetApiRequest:    ANetApiRequest{MerchantAuthentication: testAuth},
+					CustomerProfileId: "10",
+					PaymentProfile: CustomerPaymentProfileExType{
+						CustomerPaymentProfileType: CustomerPaymentProfileType{
+							Payment: &PaymentType{CreditCard: &CreditCardType{
+								CreditCardSimpleType: CreditCardSimpleType{CardNumber: "XXXX1111", ExpirationDate: "XXXX"},
+							}},
+						},
+						CustomerPaymentProfileId: "20",
+					},
+				}); err != nil {
+					t.Fatal(err)
+				}
+			},
+			request: []string{
+				`<paymentProfile><payment><creditCard><cardNumber>XXXX1111</cardNumber><expirationDate>XXXX</expirationDate>`,
+				`<customerPaymentProfileId>20</customerPaymentProfileId></paymentProfile>`,
+			},
+		},
+		{
+			name: "delete",
+			call: func(t *testing.T, c *AuthNetClient) {
+				if _, err := c.DeleteCustomerPaymentProfile(DeleteCustomerPaymentProfileRequest{
+					ANetApiRequest:           ANetApiRequest{MerchantAuthentication: testAuth},
+					CustomerProfileId:        "10",
+					CustomerPaymentProfileId: "20",
+				}); err != nil {
+					t.Fatal(err)
+				}
+			},
+			request: []string{
+				`<deleteCustomerPaymentProfileRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`<customerProfileId>10</customerProfileId><customerPaymentProfileId>20</customerPaymentProfileId>`,
+			},
+		},
+		{
+			name:     "validate",
+			response: `<directResponse>1,1,1,This transaction has been approved.</directResponse>`,
+			call: func(t *testing.T, c *AuthNetClient) {
+				res, err := c.ValidateCustomerPaymentProfile(ValidateCustomerPaymentProfileRequest{
+					ANetApiRequest:           ANetApiRequest{MerchantAuthentication: testAuth},
+					CustomerProfileId:        "10",
+					CustomerPaymentProfileId: "20",
+					CardCode:                 "123",
+					ValidationMode:           ValidationModeTestMode,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if res.DirectResponse != "1,1,1,This transaction has been approved." {
+					t.Errorf("unexpected response %+v", res)
+				}
+			},
+			request: []string{
+				`<validateCustomerPaymentProfileRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`<customerProfileId>10</customerProfileId><customerPaymentProfileId>20</customerPaymentProfileId>` +
+					`<cardCode>123</cardCode><validationMode>testMode</validationMode>`,
+			},
+		},
+	})
+}
+
+func TestUpdateCustomerPaymentProfileValidatesUnmaskedNumbers(t *testing.T) {
+	client, recorded := newRecordingClient(t, "")
+	client.config.ValidateRequests = true
+	_, err := client.UpdateCustomerPaymentProfile(UpdateCustomerPaymentProfileRequest{
+		ANetApiRequest:    ANetApiRequest{MerchantAuthentication: testAuth},
+		CustomerProfileId: "10",
+		PaymentProfile: CustomerPaymentProfileExType{
+			CustomerPaymentProfileType: CustomerPaymentProfileType{
+				Payment: &PaymentType{BankAccount: &BankAccountType{
+					RoutingNumber: "021000022",
+					AccountNumber: "XXXX6789",
+					NameOnAccount: "John Doe",
+				}},
+			},
+			CustomerPaymentProfileId: "20",
+		},
+	})
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) || len(recorded.String()) != 0 {
+		t.Errorf("expected the invalid routing number to be rejected before sending, got %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+package authnet
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+type CreateCustomerProfileRequest struct {
+	ANetApiRequest
+	XMLName        xml.Name            `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd createCustomerProfileRequest"`
+	Profile        CustomerProfileType `xml:"profile" validation:"required"`
+	ValidationMode ValidationModeEnum  `xml:"validationMode,omitempty"`
+}
+
+type CreateCustomerProfileResponse struct {
+	ANetApiResponse
+	XMLName                       xml.Name            `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd createCustomerProfileResponse"`
+	CustomerProfileId             string              `xml:"customerProfileId,omitempty"`
+	CustomerPaymentProfileIdList  *NumericStringsType `xml:"customerPaymentProfileIdList,omitempty"`
+	CustomerShippingAddressIdList *NumericStringsType `xml:"customerShippingAddressIdList,omitempty"`
+	ValidationDirectResponseList  *ArrayOfString      `xml:"validationDirectResponseList,omitempty"`
+}
+
+type UpdateCustomerProfileRequest struct {
+	ANetApiRequest
+	XMLName xml.Name              `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd updateCustomerProfileRequest"`
+	Profile CustomerProfileExType `xml:"profile" validation:"required"`
+}
+
+type UpdateCustomerProfileResponse struct {
+	ANetApiResponse
+	XMLName xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd updateCustomerProfileResponse"`
+}
+
+type DeleteCustomerProfileRequest struct {
+	ANetApiRequest
+	XMLName           xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd deleteCustomerProfileRequest"`
+	CustomerProfileId string   `xml:"customerProfileId" validation:"required,numeric"`
+}
+
+type DeleteCustomerProfileResponse struct {
+	ANetApiResponse
+	XMLName xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd deleteCustomerProfileResponse"`
+}
+
+type GetCustomerProfileIdsRequest struct {
+	ANetApiRequest
+	XMLName xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getCustomerProfileIdsRequest"`
+}
+
+type GetCustomerProfileIdsResponse struct {
+	ANetApiResponse
+	XMLName xml.Name            `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getCustomerProfileIdsResponse"`
+	Ids     *NumericStringsType `xml:"ids,omitempty"`
+}
+
+// CreateCustomerProfileFromTransactionRequest creates a customer profile from the payment and customer information of
+// an existing successful transaction. When CustomerProfileId is set, the payment and shipping information are added to
+// that profile instead of creating a new one.
+type CreateCustomerProfileFromTransactionRequest struct {
+	ANetApiRequest
+	XMLName                xml.Name                 `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd createCustomerProfileFromTransactionRequest"`
+	TransId                string                   `xml:"transId" validation:"required,numeric"`
+	Customer               *CustomerProfileBaseType `xml:"customer,omitempty"`
+	CustomerProfileId      string                   `xml:"customerProfileId,omitempty" validation:"numeric"`
+	DefaultPaymentProfile  *bool                    `xml:"defaultPaymentProfile,omitempty"`
+	DefaultShippingAddress *bool                    `xml:"defaultShippingAddress,omitempty"`
+	ProfileType            CustomerProfileTypeEnum  `xml:"profileType,omitempty"`
+}
+
+// CreateCustomerProfile creates a new customer profile along with any payment profiles and shipping addresses included
+// in the request.
+func (c *AuthNetClient) CreateCustomerProfile(req CreateCustomerProfileRequest) (*CreateCustomerProfileResponse, error) {
+	return c.CreateCustomerProfileContext(context.Background(), req)
+}
+
+// CreateCustomerProfileContext is like CreateCustomerProfile but uses ctx for the request.
+func (c *AuthNetClient) CreateCustomerProfileContext(ctx context.Context, req CreateCustomerProfileRequest) (*CreateCustomerProfileResponse, error) {
+	var res CreateCustomerProfileResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// CreateCustomerProfileFromTransaction creates a customer profile, payment profile and shipping address from an
+// existing successful transaction such as the TransId of a CreateTransactionResponse.
+func (c *AuthNetClient) CreateCustomerProfileFromTransaction(req CreateCustomerProfileFromTransactionRequest) (*CreateCustomerProfileResponse, error) {
+	return c.CreateCustomerProfileFromTransactionContext(context.Background(), req)
+}
+
+// CreateCustomerProfileFromTransactionContext is like CreateCustomerProfileFromTransaction but uses ctx for the
+// request.
+func (c *AuthNetClient) CreateCustomerProfileFromTransactionContext(ctx context.Context, req CreateCustomerProfileFromTransactionRequest) (*CreateCustomerProfileResponse, error) {
+	var res CreateCustomerProfileResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetCustomerProfile retrieves an existing customer profile along with all the associated payment profiles and shipping
+// addresses.
+func (c *AuthNetClient) GetCustomerProfile(req GetCustomerProfileRequest) (*GetCustomerProfileResponse, error) {
+	return c.GetCustomerProfileContext(context.Background(), req)
+}
+
+// GetCustomerProfileContext is like GetCustomerProfile but uses ctx for the request.
+func (c *AuthNetClient) GetCustomerProfileContext(ctx context.Context, req GetCustomerProfileRequest) (*GetCustomerProfileResponse, error) {
+	var res GetCustomerProfileResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// UpdateCustomerProfile updates the base information of an existing customer profile. Payment profiles and shipping
+// addresses are updated through their own requests.
+func (c *AuthNetClient) UpdateCustomerProfile(req UpdateCustomerProfileRequest) (*UpdateCustomerProfileResponse, error) {
+	return c.UpdateCustomerProfileContext(context.Background(), req)
+}
+
+// UpdateCustomerProfileContext is like UpdateCustomerProfile but uses ctx for the request.
+func (c *AuthNetClient) UpdateCustomerProfileContext(ctx context.Context, req UpdateCustomerProfileRequest) (*UpdateCustomerProfileResponse, error) {
+	var res UpdateCustomerProfileResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// DeleteCustomerProfile deletes an existing customer profile along with all the associated payment profiles and
+// shipping addresses.
+func (c *AuthNetClient) DeleteCustomerProfile(req DeleteCustomerProfileRequest) (*DeleteCustomerProfileResponse, error) {
+	return c.DeleteCustomerProfileContext(context.Background(), req)
+}
+
+// DeleteCustomerProfileContext is like DeleteCustomerProfile but uses ctx for the request.
+func (c *AuthNetClient) DeleteCustomerProfileContext(ctx context.Context, req DeleteCustomerProfileRequest) (*DeleteCustomerProfileResponse, error) {
+	var res DeleteCustomerProfileResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetCustomerProfileIds retrieves the IDs of every customer profile associated with the merchant.
+func (c *AuthNetClient) GetCustomerProfileIds(req GetCustomerProfileIdsRequest) (*GetCustomerProfileIdsResponse, error) {
+	return c.GetCustomerProfileIdsContext(context.Background(), req)
+}
+
+// GetCustomerProfileIdsContext is like GetCustomerProfileIds but uses ctx for the request.
+func (c *AuthNetClient) GetCustomerProfileIdsContext(ctx context.Context, req GetCustomerProfileIdsRequest) (*GetCustomerProfileIdsResponse, error) {
+	var res GetCustomerProfileIdsResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,247 @@
+package authnet
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+var testAuth = MerchantAuthenticationType{Name: "login", TransactionKey: "key"}
+
+func TestCustomerProfileOperations(t *testing.T) {
+	runOperationCases(t, []operationCase{
+		{
+			name: "create",
+			response: `<customerProfileId>10</customerProfileId>` +
+				`<customerPaymentProfileIdList><numericString>20</numericString></customerPaymentProfileIdList>` +
+				`<customerShippingAddressIdList><numericString>30</numericString></customerShippingAddressIdList>` +
+				`<validationDirectResponseList><string>1,1,1,This transaction has been approved.</string></validationDirectResponseList>`,
+			call: func(t *testing.T, c *AuthNetClient) {
+				res, err := c.CreateCustomerProfile(CreateCustomerProfileRequest{
+					ANetApiRequest: ANetApiRequest{MerchantAuthentication: testAuth, RefId: "ref"},
+					Profile: CustomerProfileType{
+						CustomerProfileBaseType: CustomerProfileBaseType{MerchantCustomerId: "M1", Email: "m1@example.com"},
+						PaymentProfiles: []CustomerPaymentProfileType{{
+							Payment: &PaymentType{CreditCard: &CreditCardType{
+								CreditCardSimpleType: CreditCardSimpleType{CardNumber: "4111111111111111", ExpirationDate: "2030-12"},
+							}},
+						}},
+						ShipToList: []CustomerAddressType{{NameAndAddressType: NameAndAddressType{FirstName: "Ada"}}},
+					},
+					ValidationMode: ValidationModeTestMode,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if res.CustomerProfileId != "10" || res.CustomerPaymentProfileIdList.NumericString[0] != "20" ||
+					res.CustomerShippingAddressIdList.NumericString[0] != "30" || len(res.ValidationDirectResponseList.String) != 1 {
+					t.Errorf("unexpected response %+v", res)
+				}
+			},
+			request: []string{
+				`<createCustomerProfileRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd">`,
+				`<merchantAuthentication><name>login</name><transactionKey>key</transactionKey></merchantAuthentication>`,
+				`<refId>ref</refId>`,
+				`<profile><merchantCustomerId>M1</merchantCustomerId><email>m1@example.com</email>`,
+				`<paymentProfiles><payment><creditCard><cardNumber>4111111111111111</cardNumber><expirationDate>2030-12</expirationDate>`,
+				`</paymentProfiles><shipToList><firstName>Ada</firstName></shipToList></profile>`,
+				`<validationMode>testMode</validationMode>`,
+			},
+		},
+		{
+			name: "get",
+			response: `<profile><merchantCustomerId>M1</merchantCustomerId><email>m1@example.com</email>` +
+				`<customerProfileId>10</customerProfileId>` +
+				`<paymentProfiles><customerPaymentProfileId>20</customerPaymentProfileId>` +
+				`<payment><creditCard><cardNumber>XXXX1111</cardNumber><expirationDate>XXXX</expirationDate></creditCard></payment>` +
+				`</paymentProfiles>` +
+				`<shipToList><firstName>Ada</firstName><customerAddressId>30</customerAddressId></shipToList></profile>` +
+				`<subscriptionIds><subscriptionId>40</subscriptionId></subscriptionIds>`,
+			call: func(t *testing.T, c *AuthNetClient) {
+				res, err := c.GetCustomerProfile(GetCustomerProfileRequest{
+					ANetApiRequest:    ANetApiRequest{MerchantAuthentication: testAuth},
+					CustomerProfileId: "10",
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				profile := res.Profile
+				if profile == nil || profile.CustomerProfileId != "10" || profile.MerchantCustomerId != "M1" {
+					t.Fatalf("unexpected profile %+v", profile)
+				}
+				if len(profile.PaymentProfiles) != 1 || profile.PaymentProfiles[0].CustomerPaymentProfileId != "20" ||
+					profile.PaymentProfiles[0].Payment.CreditCard.CardNumber != "XXXX1111" {
+					t.Errorf("unexpected payment profiles %+v", profile.PaymentProfiles)
+				}
+				if len(profile.ShipToList) != 1 || profile.ShipToList[0].CustomerAddressId != "30" {
+					t.Errorf("unexpected shipping addresses %+v", profile.ShipToList)
+				}
+				if res.SubscriptionIds == nil || len(res.SubscriptionIds.SubscriptionId) != 1 {
+					t.Errorf("unexpected subscription ids %+v", res.SubscriptionIds)
+				}
+			},
+			request: []string{
+				`<getCustomerProfileRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`</merchantAuthentication><customerProfileId>10</customerProfileId></getCustomerProfileRequest>`,
+			},
+		},
+		{
+			name: "update",
+			call: func(t *testing.T, c *AuthNetClient) {
+				if _, err := c.UpdateCustomerProfile(UpdateCustomerProfileRequest{
+					ANetApiRequest: ANetApiRequest{MerchantAuthentication: testAuth},
+					Profile: CustomerProfileExType{
+						CustomerProfileBaseType: CustomerProfileBaseType{MerchantCustomerId: "M1", Description: "updated"},
+						CustomerProfileId:       "10",
+					},
+				}); err != nil {
+					t.Fatal(err)
+				}
+			},
+			request: []string{
+				`<updateCustomerProfileRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`<profile><merchantCustomerId>M1</merchantCustomerId><description>updated</description>` +
+					`<customerProfileId>10</customerProfileId></profile>`,
+			},
+		},
+		{
+			name: "delete",
+			call: func(t *testing.T, c *AuthNetClient) {
+				if _, err := c.DeleteCustomerProfile(DeleteCustomerProfileRequest{
+					ANetApiRequest:    ANetApiRequest{MerchantAuthentication: testAuth},
+					CustomerProfileId: "10",
+				}); err != nil {
+					t.Fatal(err)
+				}
+			},
+			request: []string{
+				`<deleteCustomerProfileRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`</merchantAuthentication><customerProfileId>10</customerProfileId></deleteCustomerProfileRequest>`,
+			},
+		},
+		{
+			name:     "ids",
+			response: `<ids><numericString>10</numericString><numericString>11</numericString></ids>`,
+			call: func(t *testing.T, c *AuthNetClient) {
+				res, err := c.GetCustomerProfileIds(GetCustomerProfileIdsRequest{
+					ANetApiRequest: ANetApiRequest{MerchantAuthentication: testAuth},
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if res.Ids == nil || len(res.Ids.NumericString) != 2 || res.Ids.NumericString[1] != "11" {
+					t.Errorf("unexpected ids %+v", res.Ids)
+				}
+			},
+			request: []string{
+				`<getCustomerProfileIdsRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`</merchantAuthentication></getCustomerProfileIdsRequest>`,
+			},
+		},
+	})
+}
+
+func TestCreateCustomerProfileFromTransaction(t *testing.T) {
+	// The gateway answers with the response of createCustomerProfileRequest.
+	client, recorded := newRecordingClientNamed(t, "createCustomerProfileResponse",
+		`<customerProfileId>10</customerProfileId>`+
+			`<customerPaymentProfileIdList><numericString>20</numericString></customerPaymentProfileIdList>`+
+			`<customerShippingAddressIdList><numericString>30</numericString></customerShippingAddressIdList>`)
+	defaultPaymentProfile := true
+	res, err := client.CreateCustomerProfileFromTransaction(CreateCustomerProfileFromTransactionRequest{
+		ANetApiRequest:        ANetApiRequest{MerchantAuthentication: testAuth},
+		TransId:               "60000000001",
+		Customer:              &CustomerProfileBaseType{MerchantCustomerId: "M1"},
+		DefaultPaymentProfile: &defaultPaymentProfile,
+		ProfileType:           CustomerProfileTypeRegular,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.CustomerProfileId != "10" || res.CustomerPaymentProfileIdList.NumericString[0] != "20" ||
+		res.CustomerShippingAddressIdList.NumericString[0] != "30" {
+		t.Errorf("unexpected response %+v", res)
+	}
+	assertFragments(t, recorded.String(),
+		`<createCustomerProfileFromTransactionRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+		`</merchantAuthentication><transId>60000000001</transId><customer><merchantCustomerId>M1</merchantCustomerId></customer>`,
+		`<defaultPaymentProfile>true</defaultPaymentProfile><profileType>regular</profileType>`,
+	)
+}
+
+func TestCreateTransactionDecodesProfileResponse(t *testing.T) {
+	client, _ := newRecordingClient(t, `<transactionResponse><responseCode>1</responseCode><transId>60000000001</transId>`+
+		`</transactionResponse><profileResponse>`+okMessages+`<customerProfileId>10</customerProfileId>`+
+		`<customerPaymentProfileIdList><numericString>20</numericString></customerPaymentProfileIdList>`+
+		`</profileResponse>`)
+	createProfile := true
+	res, err := client.CreateTransaction(NewAuthCaptureProfileTransaction(testAuth, MustParseAmount("5.00"),
+		CustomerProfilePaymentType{CreateProfile: &createProfile}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	profile := res.ProfileResponse
+	if profile == nil || profile.CustomerProfileId != "10" || profile.Messages == nil ||
+		profile.CustomerPaymentProfileIdList.NumericString[0] != "20" {
+		t.Errorf("unexpected profile response %+v", profile)
+	}
+}
+
+// TestCustomerProfileLifecycle runs against the sandbox configured through GOGO_AUTHNET_CONFIG.
+func TestCustomerProfileLifecycle(t *testing.T) {
+	if ac == nil {
+		t.Skipf("%s not set", EnvGoGoAuthnetConfig)
+	}
+	merchantCustomerId := fmt.Sprintf("gogo%d", time.Now().Unix()%1e10)
+	created, createErr := ac.CreateCustomerProfile(CreateCustomerProfileRequest{
+		ANetApiRequest: ANetApiRequest{
+			MerchantAuthentication: ac.CreateMerchantAuthenticationType(),
+		},
+		Profile: CustomerProfileType{
+			CustomerProfileBaseType: CustomerProfileBaseType{
+				MerchantCustomerId: merchantCustomerId,
+				Email:              merchantCustomerId + "@example.com",
+			},
+		},
+	})
+	if createErr != nil {
+		t.Fatal(createErr)
+	}
+
+	fetched, getErr := ac.GetCustomerProfile(GetCustomerProfileRequest{
+		ANetApiRequest: ANetApiRequest{
+			MerchantAuthentication: ac.CreateMerchantAuthenticationType(),
+		},
+		CustomerProfileId: created.CustomerProfileId,
+	})
+	if getErr != nil {
+		t.Fatal(getErr)
+	}
+	if fetched.Profile == nil || fetched.Profile.MerchantCustomerId != merchantCustomerId {
+		t.Fatalf("expected profile with merchant customer id %s", merchantCustomerId)
+	}
+
+	if _, updateErr := ac.UpdateCustomerProfile(UpdateCustomerProfileRequest{
+		ANetApiRequest: ANetApiRequest{
+			MerchantAuthentication: ac.CreateMerchantAuthenticationType(),
+		},
+		Profile: CustomerProfileExType{
+			CustomerProfileBaseType: CustomerProfileBaseType{
+				MerchantCustomerId: merchantCustomerId,
+				Description:        "updated",
+			},
+			CustomerProfileId: created.CustomerProfileId,
+		},
+	}); updateErr != nil {
+		t.Fatal(updateErr)
+	}
+
+	if _, deleteErr := ac.DeleteCustomerProfile(DeleteCustomerProfileRequest{
+		ANetApiRequest: ANetApiRequest{
+			MerchantAuthentication: ac.CreateMerchantAuthenticationType(),
+		},
+		CustomerProfileId: created.CustomerProfileId,
+	}); deleteErr != nil {
+		t.Fatal(deleteErr)
+	}
+}
@@ -0,0 +1,117 @@
+package authnet
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+type CreateCustomerShippingAddressRequest struct {
+	ANetApiRequest
+	XMLName                xml.Name            `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd createCustomerShippingAddressRequest"`
+	CustomerProfileId      string              `xml:"customerProfileId" validation:"required,numeric"`
+	Address                CustomerAddressType `xml:"address" validation:"required"`
+	DefaultShippingAddress *bool               `xml:"defaultShippingAddress,omitempty"`
+}
+
+type CreateCustomerShippingAddressResponse struct {
+	ANetApiResponse
+	XMLName           xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd createCustomerShippingAddressResponse"`
+	CustomerProfileId string   `xml:"customerProfileId,omitempty"`
+	CustomerAddressId string   `xml:"customerAddressId,omitempty"`
+}
+
+type GetCustomerShippingAddressRequest struct {
+	ANetApiRequest
+	XMLName           xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getCustomerShippingAddressRequest"`
+	CustomerProfileId string   `xml:"customerProfileId" validation:"required,numeric"`
+	CustomerAddressId string   `xml:"customerAddressId" validation:"required,numeric"`
+}
+
+type GetCustomerShippingAddressResponse struct {
+	ANetApiResponse
+	XMLName                xml.Name               `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getCustomerShippingAddressResponse"`
+	DefaultShippingAddress *bool                  `xml:"defaultShippingAddress,omitempty"`
+	Address                *CustomerAddressExType `xml:"address,omitempty"`
+	SubscriptionIds        *SubscriptionIdList    `xml:"subscriptionIds,omitempty"`
+}
+
+type UpdateCustomerShippingAddressRequest struct {
+	ANetApiRequest
+	XMLName                xml.Name              `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd updateCustomerShippingAddressRequest"`
+	CustomerProfileId      string                `xml:"customerProfileId" validation:"required,numeric"`
+	Address                CustomerAddressExType `xml:"address" validation:"required"`
+	DefaultShippingAddress *bool                 `xml:"defaultShippingAddress,omitempty"`
+}
+
+type UpdateCustomerShippingAddressResponse struct {
+	ANetApiResponse
+	XMLName xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd updateCustomerShippingAddressResponse"`
+}
+
+type DeleteCustomerShippingAddressRequest struct {
+	ANetApiRequest
+	XMLName           xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd deleteCustomerShippingAddressRequest"`
+	CustomerProfileId string   `xml:"customerProfileId" validation:"required,numeric"`
+	CustomerAddressId string   `xml:"customerAddressId" validation:"required,numeric"`
+}
+
+type DeleteCustomerShippingAddressResponse struct {
+	ANetApiResponse
+	XMLName xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd deleteCustomerShippingAddressResponse"`
+}
+
+// CreateCustomerShippingAddress adds a new shipping address to an existing customer profile.
+func (c *AuthNetClient) CreateCustomerShippingAddress(req CreateCustomerShippingAddressRequest) (*CreateCustomerShippingAddressResponse, error) {
+	return c.CreateCustomerShippingAddressContext(context.Background(), req)
+}
+
+// CreateCustomerShippingAddressContext is like CreateCustomerShippingAddress but uses ctx for the request.
+func (c *AuthNetClient) CreateCustomerShippingAddressContext(ctx context.Context, req CreateCustomerShippingAddressRequest) (*CreateCustomerShippingAddressResponse, error) {
+	var res CreateCustomerShippingAddressResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetCustomerShippingAddress retrieves a single shipping address of an existing customer profile.
+func (c *AuthNetClient) GetCustomerShippingAddress(req GetCustomerShippingAddressRequest) (*GetCustomerShippingAddressResponse, error) {
+	return c.GetCustomerShippingAddressContext(context.Background(), req)
+}
+
+// GetCustomerShippingAddressContext is like GetCustomerShippingAddress but uses ctx for the request.
+func (c *AuthNetClient) GetCustomerShippingAddressContext(ctx context.Context, req GetCustomerShippingAddressRequest) (*GetCustomerShippingAddressResponse, error) {
+	var res GetCustomerShippingAddressResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// UpdateCustomerShippingAddress updates a shipping address of an existing customer profile.
+func (c *AuthNetClient) UpdateCustomerShippingAddress(req UpdateCustomerShippingAddressRequest) (*UpdateCustomerShippingAddressResponse, error) {
+	return c.UpdateCustomerShippingAddressContext(context.Background(), req)
+}
+
+// UpdateCustomerShippingAddressContext is like UpdateCustomerShippingAddress but uses ctx for the request.
+func (c *AuthNetClient) UpdateCustomerShippingAddressContext(ctx context.Context, req UpdateCustomerShippingAddressRequest) (*UpdateCustomerShippingAddressResponse, error) {
+	var res UpdateCustomerShippingAddressResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// DeleteCustomerShippingAddress deletes a shipping address from an existing customer profile.
+func (c *AuthNetClient) DeleteCustomerShippingAddress(req DeleteCustomerShippingAddressRequest) (*DeleteCustomerShippingAddressResponse, error) {
+	return c.DeleteCustomerShippingAddressContext(context.Background(), req)
+}
+
+// DeleteCustomerShippingAddressContext is like DeleteCustomerShippingAddress but uses ctx for the request.
+func (c *AuthNetClient) DeleteCustomerShippingAddressContext(ctx context.Context, req DeleteCustomerShippingAddressRequest) (*DeleteCustomerShippingAddressResponse, error) {
+	var res DeleteCustomerShippingAddressResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
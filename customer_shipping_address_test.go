@@ -0,0 +1,103 @@
+package authnet
+
+import (
+	"testing"
+)
+
+func TestCustomerShippingAddressOperations(t *testing.T) {
+	address := CustomerAddressType{
+		NameAndAddressType: NameAndAddressType{FirstName: "Ada", LastName: "Lovelace", City: "London"},
+		PhoneNumber:        "555-0100",
+	}
+	runOperationCases(t, []operationCase{
+		{
+			name:     "create",
+			response: `<customerProfileId>10</customerProfileId><customerAddressId>30</customerAddressId>`,
+			call: func(t *testing.T, c *AuthNetClient) {
+				defaultAddress := true
+				res, err := c.CreateCustomerShippingAddress(CreateCustomerShippingAddressRequest{
+					ANetApiRequest:         ANetApiRequest{MerchantAuthentication: testAuth},
+					CustomerProfileId:      "10",
+					Address:                address,
+					DefaultShippingAddress: &defaultAddress,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if res.CustomerProfileId != "10" || res.CustomerAddressId != "30" {
+					t.Errorf("unexpected response %+v", res)
+				}
+			},
+			request: []string{
+				`<createCustomerShippingAddressRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`<customerProfileId>10</customerProfileId><address><firstName>Ada</firstName><lastName>Lovelace</lastName>` +
+					`<city>London</city><phoneNumber>555-0100</phoneNumber></address>`,
+				`<defaultShippingAddress>true</defaultShippingAddress>`,
+			},
+		},
+		{
+			name: "get",
+			response: `<defaultShippingAddress>true</defaultShippingAddress>` +
+				`<address><firstName>Ada</firstName><email>ada@example.com</email><customerAddressId>30</customerAddressId></address>` +
+				`<subscriptionIds><subscriptionId>40</subscriptionId></subscriptionIds>`,
+			call: func(t *testing.T, c *AuthNetClient) {
+				res, err := c.GetCustomerShippingAddress(GetCustomerShippingAddressRequest{
+					ANetApiRequest:    ANetApiRequest{MerchantAuthentication: testAuth},
+					CustomerProfileId: "10",
+					CustomerAddressId: "30",
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if res.Address == nil || res.Address.CustomerAddressId != "30" || res.Address.FirstName != "Ada" ||
+					res.Address.Email != "ada@example.com" {
+					t.Errorf("unexpected address %+v", res.Address)
+				}
+				if res.DefaultShippingAddress == nil || !*res.DefaultShippingAddress {
+					t.Errorf("expected the default shipping address flag")
+				}
+				if res.SubscriptionIds == nil || len(res.SubscriptionIds.SubscriptionId) != 1 {
+					t.Errorf("unexpected subscription ids %+v", res.SubscriptionIds)
+				}
+			},
+			request: []string{
+				`<getCustomerShippingAddressRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`<customerProfileId>10</customerProfileId><customerAddressId>30</customerAddressId>`,
+			},
+		},
+		{
+			name: "update",
+			call: func(t *testing.T, c *AuthNetClient) {
+				if _, err := c.UpdateCustomerShippingAddress(UpdateCustomerShippingAddressRequest{
+					ANetApiRequest:    ANetApiRequest{MerchantAuthentication: testAuth},
+					CustomerProfileId: "10",
+					Address:           CustomerAddressExType{CustomerAddressType: address, CustomerAddressId: "30"},
+				}); err != nil {
+					t.Fatal(err)
+				}
+			},
+			request: []string{
+				`<updateCustomerShippingAddressRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`<customerProfileId>10</customerProfileId><address><firstName>Ada</firstName>`,
+				`<phoneNumber>555-0100</phoneNumber><customerAddressId>30</customerAddressId></address>` +
+					`</updateCustomerShippingAddressRequest>`,
+			},
+		},
+		{
+			name: "delete",
+			call: func(t *testing.T, c *AuthNetClient) {
+				if _, err := c.DeleteCustomerShippingAddress(DeleteCustomerShippingAddressRequest{
+					ANetApiRequest:    ANetApiRequest{MerchantAuthentication: testAuth},
+					CustomerProfileId: "10",
+					CustomerAddressId: "30",
+				}); err != nil {
+					t.Fatal(err)
+				}
+			},
+			request: []string{
+				`<deleteCustomerShippingAddressRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`<customerProfileId>10</customerProfileId><customerAddressId>30</customerAddressId>`,
+			},
+		},
+	})
+}
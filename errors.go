@@ -0,0 +1,74 @@
+package authnet
+
+// APIError is a message of a response with an Error result code, such as E00040 when a record was not found.
+// RequestError unwraps to an *APIError for each message of the response.
+type APIError struct {
+	Code string
+	Text string
+}
+
+func (e *APIError) Error() string {
+	return e.Code + ": " + e.Text
+}
+
+// Is reports whether target is an *APIError with the same code, so that errors.Is matches the Err sentinels regardless
+// of the message text.
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	return ok && t.Code == e.Code
+}
+
+// TransactionError is an error of TransactionResponse.Errors, identified by the response reason code, such as 11 for a
+// duplicate transaction. RequestError unwraps to a *TransactionError for each error of a failed transaction.
+type TransactionError struct {
+	Code string
+	Text string
+}
+
+func (e *TransactionError) Error() string {
+	return e.Code + ": " + e.Text
+}
+
+// Is reports whether target is a *TransactionError with the same code.
+func (e *TransactionError) Is(target error) bool {
+	t, ok := target.(*TransactionError)
+	return ok && t.Code == e.Code
+}
+
+// TransportError is set as RequestError.Err when the request could not be sent or the response could not be read, for
+// example because the connection failed or the gateway responded with a non 2xx HTTP status.
+type TransportError struct {
+	Err error
+}
+
+func (e *TransportError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// Sentinels for common gateway failures, to be matched with errors.Is.
+var (
+	// ErrAuthenticationFailed the API login id or transaction key is invalid.
+	ErrAuthenticationFailed = &APIError{Code: "E00007", Text: "User authentication failed due to invalid authentication values."}
+	// ErrAccountInactive the account or API user is inactive.
+	ErrAccountInactive = &APIError{Code: "E00008", Text: "User authentication failed. The account or API user is inactive."}
+	// ErrAccessDenied the API user does not have permission to call the API, such as the Transaction Details API.
+	ErrAccessDenied = &APIError{Code: "E00011", Text: "Access denied. You do not have permissions to call the API."}
+	// ErrTransactionUnsuccessful the transaction was declined or failed, see the unwrapped TransactionError values.
+	ErrTransactionUnsuccessful = &APIError{Code: "E00027", Text: "The transaction was unsuccessful."}
+	// ErrDuplicateRecord a customer profile, payment profile or shipping address with the same details already exists.
+	ErrDuplicateRecord = &APIError{Code: "E00039", Text: "A duplicate record already exists."}
+	// ErrRecordNotFound the requested profile, subscription or transaction does not exist.
+	ErrRecordNotFound = &APIError{Code: "E00040", Text: "The record cannot be found."}
+
+	// ErrDuplicateTransaction a transaction with the same details was submitted within the duplicate window.
+	ErrDuplicateTransaction = &TransactionError{Code: "11", Text: "A duplicate transaction has been submitted."}
+	// ErrInvalidMerchantLogin the API login id is invalid or the account is inactive.
+	ErrInvalidMerchantLogin = &TransactionError{Code: "13", Text: "The merchant login ID or password is invalid or the account is inactive."}
+	// ErrInvalidTransactionKey the transaction key is missing or invalid. Outside of transactions the gateway reports
+	// this as ErrAuthenticationFailed.
+	ErrInvalidTransactionKey = &TransactionError{Code: "103", Text: "A valid fingerprint, transaction key, or password is required for this transaction."}
+)
@@ -0,0 +1,107 @@
+package authnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestRequestErrorMatchesSentinels(t *testing.T) {
+	reqErr := &RequestError{
+		Response: &ErrorResponse{Messages: Messages{
+			ResultCode: MessageTypeError,
+			Message:    []Message{{Code: "E00027", Text: "The transaction was unsuccessful."}},
+		}},
+		TransactionErrors: []Error{{ErrorCode: "11", ErrorText: "A duplicate transaction has been submitted."}},
+	}
+	wrapped := fmt.Errorf("charging order: %w", reqErr)
+	cases := []struct {
+		target  error
+		matches bool
+	}{
+		{ErrTransactionUnsuccessful, true},
+		{ErrDuplicateTransaction, true},
+		{ErrRecordNotFound, false},
+		{ErrInvalidMerchantLogin, false},
+		{&APIError{Code: "E00027"}, true},
+		{&TransactionError{Code: "11"}, true},
+		{&TransactionError{Code: "E00027"}, false},
+	}
+	for _, c := range cases {
+		if matches := errors.Is(reqErr, c.target); matches != c.matches {
+			t.Errorf("%v: expected %t, got %t", c.target, c.matches, matches)
+		}
+		if matches := errors.Is(wrapped, c.target); matches != c.matches {
+			t.Errorf("wrapped %v: expected %t, got %t", c.target, c.matches, matches)
+		}
+	}
+
+	var apiErr *APIError
+	if !errors.As(wrapped, &apiErr) || apiErr.Code != "E00027" {
+		t.Errorf("expected errors.As to find the APIError, got %v", apiErr)
+	}
+	var transactionErr *TransactionError
+	if !errors.As(wrapped, &transactionErr) || transactionErr.Code != "11" {
+		t.Errorf("expected errors.As to find the TransactionError, got %v", transactionErr)
+	}
+	var transportErr *TransportError
+	if errors.As(wrapped, &transportErr) || reqErr.IsTransportError() {
+		t.Errorf("expected no TransportError")
+	}
+}
+
+func TestRequestErrorFromGateway(t *testing.T) {
+	client := newFakeClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<?xml version="1.0" encoding="utf-8"?>
+<createTransactionResponse xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd">
+<messages><resultCode>Error</resultCode><message><code>E00027</code><text>The transaction was unsuccessful.</text></message></messages>
+<transactionResponse><responseCode>3</responseCode><transId>0</transId>
+<errors><error><errorCode>11</errorCode><errorText>A duplicate transaction has been submitted.</errorText></error></errors>
+</transactionResponse></createTransactionResponse>`))
+	})
+	res, err := client.CreateTransaction(CreateTransactionRequestType{})
+	if res == nil || res.TransactionResponse.ResponseCode != "3" {
+		t.Errorf("expected the response along with the error, got %+v", res)
+	}
+	if !errors.Is(err, ErrDuplicateTransaction) || !errors.Is(err, ErrTransactionUnsuccessful) {
+		t.Errorf("expected the duplicate transaction sentinels to match, got %v", err)
+	}
+
+	client = newFakeClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<?xml version="1.0" encoding="utf-8"?>
+<getMerchantDetailsResponse xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd">
+<messages><resultCode>Error</resultCode><message><code>E00007</code><text>User authentication failed due to invalid authentication values.</text></message></messages>
+</getMerchantDetailsResponse>`))
+	})
+	if _, err := client.GetMerchantDetails(GetMerchantDetailsRequest{}); !errors.Is(err, ErrAuthenticationFailed) || errors.Is(err, ErrAccountInactive) {
+		t.Errorf("expected ErrAuthenticationFailed, got %v", err)
+	}
+}
+
+func TestRequestErrorTransport(t *testing.T) {
+	client := newFakeClient(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	})
+	_, err := client.GetMerchantDetails(GetMerchantDetailsRequest{})
+	var transportErr *TransportError
+	if !errors.As(fmt.Errorf("wrapped: %w", err), &transportErr) {
+		t.Fatalf("expected errors.As to find a TransportError, got %v", err)
+	}
+	var reqErr *RequestError
+	if !errors.As(err, &reqErr) || !reqErr.IsTransportError() || reqErr.StatusCode != http.StatusServiceUnavailable ||
+		string(reqErr.Body) != "unavailable\n" {
+		t.Errorf("unexpected RequestError %+v", reqErr)
+	}
+	if errors.Is(err, ErrAuthenticationFailed) || reqErr.IsContextError() {
+		t.Errorf("expected only a transport error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.GetMerchantDetailsContext(ctx, GetMerchantDetailsRequest{})
+	if !errors.Is(err, context.Canceled) || !errors.As(err, &reqErr) || !reqErr.IsContextError() || !errors.As(err, &transportErr) {
+		t.Errorf("expected a canceled TransportError, got %v", err)
+	}
+}
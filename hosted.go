@@ -0,0 +1,242 @@
+package authnet
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"reflect"
+	"strconv"
+)
+
+type HostedPaymentReturnOptions struct {
+	ShowReceipt   *bool  `json:"showReceipt,omitempty"`
+	Url           string `json:"url,omitempty"`
+	UrlText       string `json:"urlText,omitempty"`
+	CancelUrl     string `json:"cancelUrl,omitempty"`
+	CancelUrlText string `json:"cancelUrlText,omitempty"`
+}
+
+type HostedPaymentButtonOptions struct {
+	Text string `json:"text,omitempty"`
+}
+
+type HostedPaymentStyleOptions struct {
+	BgColor string `json:"bgColor,omitempty"`
+}
+
+type HostedPaymentPaymentOptions struct {
+	CardCodeRequired *bool `json:"cardCodeRequired,omitempty"`
+	ShowCreditCard   *bool `json:"showCreditCard,omitempty"`
+	ShowBankAccount  *bool `json:"showBankAccount,omitempty"`
+	// CustomerProfileId allows the customer to pay with the payment profiles of the customer profile set on the
+	// transaction request.
+	CustomerProfileId *bool `json:"customerProfileId,omitempty"`
+}
+
+type HostedPaymentSecurityOptions struct {
+	Captcha *bool `json:"captcha,omitempty"`
+}
+
+type HostedPaymentAddressOptions struct {
+	Show     *bool `json:"show,omitempty"`
+	Required *bool `json:"required,omitempty"`
+}
+
+type HostedPaymentCustomerOptions struct {
+	ShowEmail         *bool `json:"showEmail,omitempty"`
+	RequiredEmail     *bool `json:"requiredEmail,omitempty"`
+	AddPaymentProfile *bool `json:"addPaymentProfile,omitempty"`
+}
+
+type HostedPaymentOrderOptions struct {
+	Show         *bool  `json:"show,omitempty"`
+	MerchantName string `json:"merchantName,omitempty"`
+}
+
+type HostedPaymentIFrameCommunicatorUrl struct {
+	Url string `json:"url,omitempty"`
+}
+
+// HostedPaymentSettings configures the Accept Hosted payment form. Each option that is set is serialized to JSON and
+// sent as a single SettingType by Settings. Options left nil use the gateway defaults.
+type HostedPaymentSettings struct {
+	ReturnOptions          *HostedPaymentReturnOptions
+	ButtonOptions          *HostedPaymentButtonOptions
+	StyleOptions           *HostedPaymentStyleOptions
+	PaymentOptions         *HostedPaymentPaymentOptions
+	SecurityOptions        *HostedPaymentSecurityOptions
+	ShippingAddressOptions *HostedPaymentAddressOptions
+	BillingAddressOptions  *HostedPaymentAddressOptions
+	CustomerOptions        *HostedPaymentCustomerOptions
+	OrderOptions           *HostedPaymentOrderOptions
+	IFrameCommunicatorUrl  *HostedPaymentIFrameCommunicatorUrl
+}
+
+// Settings builds the ArrayOfSetting for GetHostedPaymentPageRequest.HostedPaymentSettings.
+func (s HostedPaymentSettings) Settings() (*ArrayOfSetting, error) {
+	return jsonSettings([]namedSetting{
+		{"hostedPaymentReturnOptions", s.ReturnOptions},
+		{"hostedPaymentButtonOptions", s.ButtonOptions},
+		{"hostedPaymentStyleOptions", s.StyleOptions},
+		{"hostedPaymentPaymentOptions", s.PaymentOptions},
+		{"hostedPaymentSecurityOptions", s.SecurityOptions},
+		{"hostedPaymentShippingAddressOptions", s.ShippingAddressOptions},
+		{"hostedPaymentBillingAddressOptions", s.BillingAddressOptions},
+		{"hostedPaymentCustomerOptions", s.CustomerOptions},
+		{"hostedPaymentOrderOptions", s.OrderOptions},
+		{"hostedPaymentIFrameCommunicatorUrl", s.IFrameCommunicatorUrl},
+	})
+}
+
+type namedSetting struct {
+	name  string
+	value any
+}
+
+// jsonSettings serializes every non nil setting value to JSON.
+func jsonSettings(settings []namedSetting) (*ArrayOfSetting, error) {
+	var array ArrayOfSetting
+	for _, setting := range settings {
+		if reflect.ValueOf(setting.value).IsNil() {
+			continue
+		}
+		value, mErr := json.Marshal(setting.value)
+		if mErr != nil {
+			return nil, errors.Join(errors.New("unable to marshal setting "+setting.name), mErr)
+		}
+		array.Setting = append(array.Setting, SettingType{
+			SettingName:  setting.name,
+			SettingValue: string(value),
+		})
+	}
+	return &array, nil
+}
+
+// GetHostedPaymentPageRequest requests a token for the Accept Hosted payment form. The transaction request is
+// processed once the customer submits the form.
+type GetHostedPaymentPageRequest struct {
+	ANetApiRequest
+	XMLName               xml.Name               `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getHostedPaymentPageRequest"`
+	TransactionRequest    TransactionRequestType `xml:"transactionRequest" validation:"required"`
+	HostedPaymentSettings *ArrayOfSetting        `xml:"hostedPaymentSettings,omitempty"`
+}
+
+type GetHostedPaymentPageResponse struct {
+	ANetApiResponse
+	XMLName xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getHostedPaymentPageResponse"`
+	// Token is posted to the Accept Hosted form and is valid for 15 minutes.
+	Token string `xml:"token,omitempty"`
+}
+
+// GetHostedPaymentPage requests a token for the Accept Hosted payment form.
+func (c *AuthNetClient) GetHostedPaymentPage(req GetHostedPaymentPageRequest) (*GetHostedPaymentPageResponse, error) {
+	return c.GetHostedPaymentPageContext(context.Background(), req)
+}
+
+// GetHostedPaymentPageContext is like GetHostedPaymentPage but uses ctx for the request.
+func (c *AuthNetClient) GetHostedPaymentPageContext(ctx context.Context, req GetHostedPaymentPageRequest) (*GetHostedPaymentPageResponse, error) {
+	var res GetHostedPaymentPageResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+type HostedProfileBillingAddressOptionsEnum = string
+
+const (
+	HostedProfileBillingAddressOptionsShowBillingAddress HostedProfileBillingAddressOptionsEnum = "showBillingAddress"
+	HostedProfileBillingAddressOptionsShowNone                                                  = "showNone"
+)
+
+type HostedProfileManageOptionsEnum = string
+
+const (
+	HostedProfileManageOptionsShowAll      HostedProfileManageOptionsEnum = "showAll"
+	HostedProfileManageOptionsShowPayment                                 = "showPayment"
+	HostedProfileManageOptionsShowShipping                                = "showShipping"
+)
+
+type HostedProfilePaymentOptionsEnum = string
+
+const (
+	HostedProfilePaymentOptionsShowAll         HostedProfilePaymentOptionsEnum = "showAll"
+	HostedProfilePaymentOptionsShowCreditCard                                  = "showCreditCard"
+	HostedProfilePaymentOptionsShowBankAccount                                 = "showBankAccount"
+)
+
+// HostedProfileSettings configures the Accept Customer hosted form. Each option that is set is sent as a single
+// SettingType by Settings. Options left empty use the gateway defaults.
+type HostedProfileSettings struct {
+	ReturnUrl              string
+	ReturnUrlText          string
+	PageBorderVisible      *bool
+	HeadingBgColor         string
+	IFrameCommunicatorUrl  string
+	ValidationMode         ValidationModeEnum
+	BillingAddressRequired *bool
+	CardCodeRequired       *bool
+	BillingAddressOptions  HostedProfileBillingAddressOptionsEnum
+	ManageOptions          HostedProfileManageOptionsEnum
+	PaymentOptions         HostedProfilePaymentOptionsEnum
+	SaveButtonText         string
+}
+
+// Settings builds the ArrayOfSetting for GetHostedProfilePageRequest.HostedProfileSettings.
+func (s HostedProfileSettings) Settings() *ArrayOfSetting {
+	var array ArrayOfSetting
+	addString := func(name string, value string) {
+		if len(value) > 0 {
+			array.Setting = append(array.Setting, SettingType{SettingName: name, SettingValue: value})
+		}
+	}
+	addBool := func(name string, value *bool) {
+		if value != nil {
+			addString(name, strconv.FormatBool(*value))
+		}
+	}
+	addString("hostedProfileReturnUrl", s.ReturnUrl)
+	addString("hostedProfileReturnUrlText", s.ReturnUrlText)
+	addBool("hostedProfilePageBorderVisible", s.PageBorderVisible)
+	addString("hostedProfileHeadingBgColor", s.HeadingBgColor)
+	addString("hostedProfileIFrameCommunicatorUrl", s.IFrameCommunicatorUrl)
+	addString("hostedProfileValidationMode", s.ValidationMode)
+	addBool("hostedProfileBillingAddressRequired", s.BillingAddressRequired)
+	addBool("hostedProfileCardCodeRequired", s.CardCodeRequired)
+	addString("hostedProfileBillingAddressOptions", s.BillingAddressOptions)
+	addString("hostedProfileManageOptions", s.ManageOptions)
+	addString("hostedProfilePaymentOptions", s.PaymentOptions)
+	addString("hostedProfileSaveButtonText", s.SaveButtonText)
+	return &array
+}
+
+// GetHostedProfilePageRequest requests a token for the Accept Customer hosted form which lets a customer manage the
+// payment profiles and shipping addresses of their customer profile.
+type GetHostedProfilePageRequest struct {
+	ANetApiRequest
+	XMLName               xml.Name        `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getHostedProfilePageRequest"`
+	CustomerProfileId     string          `xml:"customerProfileId" validation:"required,numeric"`
+	HostedProfileSettings *ArrayOfSetting `xml:"hostedProfileSettings,omitempty"`
+}
+
+type GetHostedProfilePageResponse struct {
+	ANetApiResponse
+	XMLName xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getHostedProfilePageResponse"`
+	// Token is posted to the Accept Customer form and is valid for 15 minutes.
+	Token string `xml:"token,omitempty"`
+}
+
+// GetHostedProfilePage requests a token for the Accept Customer hosted form.
+func (c *AuthNetClient) GetHostedProfilePage(req GetHostedProfilePageRequest) (*GetHostedProfilePageResponse, error) {
+	return c.GetHostedProfilePageContext(context.Background(), req)
+}
+
+// GetHostedProfilePageContext is like GetHostedProfilePage but uses ctx for the request.
+func (c *AuthNetClient) GetHostedProfilePageContext(ctx context.Context, req GetHostedProfilePageRequest) (*GetHostedProfilePageResponse, error) {
+	var res GetHostedProfilePageResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
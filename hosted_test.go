@@ -0,0 +1,167 @@
+package authnet
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHostedPaymentSettings(t *testing.T) {
+	yes, no := true, false
+	cases := []struct {
+		name     string
+		settings HostedPaymentSettings
+		expected []SettingType
+	}{
+		{"empty", HostedPaymentSettings{}, nil},
+		{
+			"filled",
+			HostedPaymentSettings{
+				ReturnOptions: &HostedPaymentReturnOptions{
+					ShowReceipt: &no, Url: "https://example.com/done", UrlText: "Continue",
+					CancelUrl: "https://example.com/cancel", CancelUrlText: "Cancel",
+				},
+				ButtonOptions:          &HostedPaymentButtonOptions{Text: "Pay"},
+				StyleOptions:           &HostedPaymentStyleOptions{BgColor: "blue"},
+				PaymentOptions:         &HostedPaymentPaymentOptions{CardCodeRequired: &yes, ShowBankAccount: &no},
+				SecurityOptions:        &HostedPaymentSecurityOptions{Captcha: &no},
+				ShippingAddressOptions: &HostedPaymentAddressOptions{Show: &no, Required: &no},
+				BillingAddressOptions:  &HostedPaymentAddressOptions{Show: &yes, Required: &yes},
+				CustomerOptions:        &HostedPaymentCustomerOptions{ShowEmail: &yes, RequiredEmail: &no, AddPaymentProfile: &yes},
+				OrderOptions:           &HostedPaymentOrderOptions{Show: &yes, MerchantName: "Test Merchant"},
+				IFrameCommunicatorUrl:  &HostedPaymentIFrameCommunicatorUrl{Url: "https://example.com/communicator"},
+			},
+			[]SettingType{
+				{"hostedPaymentReturnOptions", `{"showReceipt":false,"url":"https://example.com/done","urlText":"Continue","cancelUrl":"https://example.com/cancel","cancelUrlText":"Cancel"}`},
+				{"hostedPaymentButtonOptions", `{"text":"Pay"}`},
+				{"hostedPaymentStyleOptions", `{"bgColor":"blue"}`},
+				{"hostedPaymentPaymentOptions", `{"cardCodeRequired":true,"showBankAccount":false}`},
+				{"hostedPaymentSecurityOptions", `{"captcha":false}`},
+				{"hostedPaymentShippingAddressOptions", `{"show":false,"required":false}`},
+				{"hostedPaymentBillingAddressOptions", `{"show":true,"required":true}`},
+				{"hostedPaymentCustomerOptions", `{"showEmail":true,"requiredEmail":false,"addPaymentProfile":true}`},
+				{"hostedPaymentOrderOptions", `{"show":true,"merchantName":"Test Merchant"}`},
+				{"hostedPaymentIFrameCommunicatorUrl", `{"url":"https://example.com/communicator"}`},
+			},
+		},
+		{
+			"nil options skipped",
+			HostedPaymentSettings{ButtonOptions: &HostedPaymentButtonOptions{}, OrderOptions: &HostedPaymentOrderOptions{Show: &no}},
+			[]SettingType{
+				{"hostedPaymentButtonOptions", `{}`},
+				{"hostedPaymentOrderOptions", `{"show":false}`},
+			},
+		},
+	}
+	for _, c := range cases {
+		array, err := c.settings.Settings()
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if !reflect.DeepEqual(array, &ArrayOfSetting{Setting: c.expected}) {
+			t.Errorf("%s: expected %+v, got %+v", c.name, c.expected, array.Setting)
+		}
+	}
+}
+
+func TestGetHostedPaymentPage(t *testing.T) {
+	client, recorded := newRecordingClient(t, `<token>hosted-token</token>`)
+	settings, err := HostedPaymentSettings{ButtonOptions: &HostedPaymentButtonOptions{Text: "Pay"}}.Settings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.GetHostedPaymentPage(GetHostedPaymentPageRequest{
+		ANetApiRequest: ANetApiRequest{MerchantAuthentication: testAuth},
+		TransactionRequest: TransactionRequestType{
+			TransactionType: TransactionTypeAuthCaptureTransaction,
+			Amount:          amountPointer("20.00"),
+		},
+		HostedPaymentSettings: settings,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Token != "hosted-token" {
+		t.Errorf("unexpected token %q", res.Token)
+	}
+	assertFragments(t, recorded.String(),
+		`<getHostedPaymentPageRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+		`<transactionRequest><transactionType>authCaptureTransaction</transactionType><amount>20.00</amount></transactionRequest>`,
+		`<hostedPaymentSettings><setting><settingName>hostedPaymentButtonOptions</settingName>`+
+			`<settingValue>{&#34;text&#34;:&#34;Pay&#34;}</settingValue></setting></hostedPaymentSettings>`,
+	)
+}
+
+func TestHostedProfileSettings(t *testing.T) {
+	yes, no := true, false
+	cases := []struct {
+		name     string
+		settings HostedProfileSettings
+		expected []SettingType
+	}{
+		{"empty", HostedProfileSettings{}, nil},
+		{
+			"filled",
+			HostedProfileSettings{
+				ReturnUrl:              "https://example.com/account",
+				ReturnUrlText:          "Back",
+				PageBorderVisible:      &no,
+				HeadingBgColor:         "#e0e0e0",
+				IFrameCommunicatorUrl:  "https://example.com/communicator",
+				ValidationMode:         ValidationModeOldLiveMode,
+				BillingAddressRequired: &yes,
+				CardCodeRequired:       &yes,
+				BillingAddressOptions:  HostedProfileBillingAddressOptionsShowNone,
+				ManageOptions:          HostedProfileManageOptionsShowPayment,
+				PaymentOptions:         HostedProfilePaymentOptionsShowCreditCard,
+				SaveButtonText:         "Save",
+			},
+			[]SettingType{
+				{"hostedProfileReturnUrl", "https://example.com/account"},
+				{"hostedProfileReturnUrlText", "Back"},
+				{"hostedProfilePageBorderVisible", "false"},
+				{"hostedProfileHeadingBgColor", "#e0e0e0"},
+				{"hostedProfileIFrameCommunicatorUrl", "https://example.com/communicator"},
+				{"hostedProfileValidationMode", "oldLiveMode"},
+				{"hostedProfileBillingAddressRequired", "true"},
+				{"hostedProfileCardCodeRequired", "true"},
+				{"hostedProfileBillingAddressOptions", "showNone"},
+				{"hostedProfileManageOptions", "showPayment"},
+				{"hostedProfilePaymentOptions", "showCreditCard"},
+				{"hostedProfileSaveButtonText", "Save"},
+			},
+		},
+		{
+			"validation mode none",
+			HostedProfileSettings{ValidationMode: ValidationModeNone, CardCodeRequired: &no},
+			[]SettingType{
+				{"hostedProfileValidationMode", "none"},
+				{"hostedProfileCardCodeRequired", "false"},
+			},
+		},
+	}
+	for _, c := range cases {
+		if array := c.settings.Settings(); !reflect.DeepEqual(array, &ArrayOfSetting{Setting: c.expected}) {
+			t.Errorf("%s: expected %+v, got %+v", c.name, c.expected, array.Setting)
+		}
+	}
+}
+
+func TestGetHostedProfilePage(t *testing.T) {
+	client, recorded := newRecordingClient(t, `<token>profile-token</token>`)
+	res, err := client.GetHostedProfilePage(GetHostedProfilePageRequest{
+		ANetApiRequest:        ANetApiRequest{MerchantAuthentication: testAuth},
+		CustomerProfileId:     "10",
+		HostedProfileSettings: HostedProfileSettings{ValidationMode: ValidationModeNone}.Settings(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Token != "profile-token" {
+		t.Errorf("unexpected token %q", res.Token)
+	}
+	assertFragments(t, recorded.String(),
+		`<getHostedProfilePageRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+		`<customerProfileId>10</customerProfileId><hostedProfileSettings><setting>`+
+			`<settingName>hostedProfileValidationMode</settingName><settingValue>none</settingValue></setting></hostedProfileSettings>`,
+	)
+}
@@ -0,0 +1,239 @@
+package authnet
+
+import (
+	"context"
+	"fmt"
+)
+
+// DefaultPageSize is the page size used by the list iterators when none is provided. It is the largest page size the
+// API accepts.
+const DefaultPageSize = 1000
+
+// PageError is returned by Iterator.Err when fetching a page failed. Err is usually a *RequestError.
+type PageError struct {
+	Offset int
+	Err    error
+}
+
+func (e *PageError) Error() string {
+	return fmt.Sprintf("unable to fetch page %d: %s", e.Offset, e.Err.Error())
+}
+
+func (e *PageError) Unwrap() error {
+	return e.Err
+}
+
+// Iterator yields the items of a list request one at a time, requesting the next page from the API as needed. It stops
+// once totalNumInResultSet items have been returned, when a page comes back short, or when a page fails. When the
+// response does not report totalNumInResultSet, only a short or empty page ends the iteration.
+//
+//	it := client.TransactionListIterator(request, 0)
+//	defer it.Close()
+//	for it.Next() {
+//		transaction := it.Item()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	fetch    func(paging Paging) ([]T, int, error)
+	pageSize int
+	offset   int
+	page     []T
+	index    int
+	seen     int
+	total    int
+	item     T
+	err      error
+	done     bool
+}
+
+type TransactionIterator = Iterator[TransactionSummaryType]
+type CustomerPaymentProfileIterator = Iterator[CustomerPaymentProfileListItemType]
+type SubscriptionIterator = Iterator[SubscriptionDetail]
+
+func newIterator[T any](pageSize int, fetch func(paging Paging) ([]T, int, error)) *Iterator[T] {
+	if pageSize <= 0 || pageSize > DefaultPageSize {
+		pageSize = DefaultPageSize
+	}
+	return &Iterator[T]{
+		fetch:    fetch,
+		pageSize: pageSize,
+	}
+}
+
+// Next advances to the next item, fetching the next page when the current one is exhausted. It returns false when
+// there are no more items or an error occurred, which is then available from Err.
+func (it *Iterator[T]) Next() bool {
+	if it.done {
+		return false
+	}
+	for it.index >= len(it.page) {
+		if it.offset > 0 && (it.total > 0 && it.seen >= it.total || len(it.page) < it.pageSize) {
+			it.done = true
+			return false
+		}
+		it.offset++
+		items, total, fetchErr := it.fetch(Paging{Limit: it.pageSize, Offset: it.offset})
+		if fetchErr != nil {
+			it.err = &PageError{Offset: it.offset, Err: fetchErr}
+			it.done = true
+			return false
+		}
+		it.page, it.index, it.total = items, 0, total
+		if len(items) == 0 {
+			it.done = true
+			return false
+		}
+	}
+	it.item = it.page[it.index]
+	it.index++
+	it.seen++
+	return true
+}
+
+// Item returns the current item. It is only valid after Next returned true.
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Total returns the totalNumInResultSet reported by the last page fetched.
+func (it *Iterator[T]) Total() int {
+	return it.total
+}
+
+// Close stops the iteration. No further pages are requested.
+func (it *Iterator[T]) Close() {
+	it.done = true
+	it.page = nil
+}
+
+// TransactionListIterator iterates the transactions of a settled batch. The Paging of the request is managed by the
+// iterator and the transactions are sorted by id unless the request specifies otherwise.
+func (c *AuthNetClient) TransactionListIterator(req GetTransactionListRequest, pageSize int) *TransactionIterator {
+	return c.TransactionListIteratorContext(context.Background(), req, pageSize)
+}
+
+// TransactionListIteratorContext is like TransactionListIterator but uses ctx for every page requested.
+func (c *AuthNetClient) TransactionListIteratorContext(ctx context.Context, req GetTransactionListRequest, pageSize int) *TransactionIterator {
+	if req.Sorting == nil {
+		req.Sorting = &TransactionListSorting{OrderBy: TransactionListOrderFieldId}
+	}
+	return newIterator(pageSize, func(paging Paging) ([]TransactionSummaryType, int, error) {
+		req.Paging = &paging
+		res, err := c.GetTransactionListContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.Transactions.items(), res.TotalNumInResultSet, nil
+	})
+}
+
+// UnsettledTransactionListIterator iterates the transactions that have not been settled yet. The Paging of the request
+// is managed by the iterator and the transactions are sorted by id unless the request specifies otherwise.
+func (c *AuthNetClient) UnsettledTransactionListIterator(req GetUnsettledTransactionListRequest, pageSize int) *TransactionIterator {
+	return c.UnsettledTransactionListIteratorContext(context.Background(), req, pageSize)
+}
+
+// UnsettledTransactionListIteratorContext is like UnsettledTransactionListIterator but uses ctx for every page
+// requested.
+func (c *AuthNetClient) UnsettledTransactionListIteratorContext(ctx context.Context, req GetUnsettledTransactionListRequest, pageSize int) *TransactionIterator {
+	if req.Sorting == nil {
+		req.Sorting = &TransactionListSorting{OrderBy: TransactionListOrderFieldId}
+	}
+	return newIterator(pageSize, func(paging Paging) ([]TransactionSummaryType, int, error) {
+		req.Paging = &paging
+		res, err := c.GetUnsettledTransactionListContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.Transactions.items(), res.TotalNumInResultSet, nil
+	})
+}
+
+// TransactionListForCustomerIterator iterates the transactions of a customer profile. The Paging of the request is
+// managed by the iterator and the transactions are sorted by id unless the request specifies otherwise.
+func (c *AuthNetClient) TransactionListForCustomerIterator(req GetTransactionListForCustomerRequest, pageSize int) *TransactionIterator {
+	return c.TransactionListForCustomerIteratorContext(context.Background(), req, pageSize)
+}
+
+// TransactionListForCustomerIteratorContext is like TransactionListForCustomerIterator but uses ctx for every page
+// requested.
+func (c *AuthNetClient) TransactionListForCustomerIteratorContext(ctx context.Context, req GetTransactionListForCustomerRequest, pageSize int) *TransactionIterator {
+	if req.Sorting == nil {
+		req.Sorting = &TransactionListSorting{OrderBy: TransactionListOrderFieldId}
+	}
+	return newIterator(pageSize, func(paging Paging) ([]TransactionSummaryType, int, error) {
+		req.Paging = &paging
+		res, err := c.GetTransactionListForCustomerContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.Transactions.items(), res.TotalNumInResultSet, nil
+	})
+}
+
+// CustomerPaymentProfileListIterator iterates the payment profiles matching the search of the request. The Paging of
+// the request is managed by the iterator and the payment profiles are sorted by id unless the request specifies
+// otherwise.
+func (c *AuthNetClient) CustomerPaymentProfileListIterator(req GetCustomerPaymentProfileListRequest, pageSize int) *CustomerPaymentProfileIterator {
+	return c.CustomerPaymentProfileListIteratorContext(context.Background(), req, pageSize)
+}
+
+// CustomerPaymentProfileListIteratorContext is like CustomerPaymentProfileListIterator but uses ctx for every page
+// requested.
+func (c *AuthNetClient) CustomerPaymentProfileListIteratorContext(ctx context.Context, req GetCustomerPaymentProfileListRequest, pageSize int) *CustomerPaymentProfileIterator {
+	if req.Sorting == nil {
+		req.Sorting = &CustomerPaymentProfileSorting{OrderBy: CustomerPaymentProfileOrderFieldId}
+	}
+	return newIterator(pageSize, func(paging Paging) ([]CustomerPaymentProfileListItemType, int, error) {
+		req.Paging = &paging
+		res, err := c.GetCustomerPaymentProfileListContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		var items []CustomerPaymentProfileListItemType
+		if res.PaymentProfiles != nil {
+			items = res.PaymentProfiles.PaymentProfile
+		}
+		return items, res.TotalNumInResultSet, nil
+	})
+}
+
+// ARBSubscriptionListIterator iterates the subscriptions matching the search of the request. The Paging of the request
+// is managed by the iterator and the subscriptions are sorted by id unless the request specifies otherwise.
+func (c *AuthNetClient) ARBSubscriptionListIterator(req ARBGetSubscriptionListRequest, pageSize int) *SubscriptionIterator {
+	return c.ARBSubscriptionListIteratorContext(context.Background(), req, pageSize)
+}
+
+// ARBSubscriptionListIteratorContext is like ARBSubscriptionListIterator but uses ctx for every page requested.
+func (c *AuthNetClient) ARBSubscriptionListIteratorContext(ctx context.Context, req ARBGetSubscriptionListRequest, pageSize int) *SubscriptionIterator {
+	if req.Sorting == nil {
+		req.Sorting = &ARBGetSubscriptionListSorting{OrderBy: ARBGetSubscriptionListOrderFieldId}
+	}
+	return newIterator(pageSize, func(paging Paging) ([]SubscriptionDetail, int, error) {
+		req.Paging = &paging
+		res, err := c.ARBGetSubscriptionListContext(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		var items []SubscriptionDetail
+		if res.SubscriptionDetails != nil {
+			items = res.SubscriptionDetails.SubscriptionDetail
+		}
+		return items, res.TotalNumInResultSet, nil
+	})
+}
+
+func (a *ArrayOfTransactionSummaryType) items() []TransactionSummaryType {
+	if a == nil {
+		return nil
+	}
+	return a.Transaction
+}
@@ -0,0 +1,98 @@
+package authnet
+
+import (
+	"errors"
+	"testing"
+)
+
+// pagedFetch serves items in pages of the requested size and reports total as totalNumInResultSet.
+func pagedFetch(items []int, total int, requested *[]Paging) func(paging Paging) ([]int, int, error) {
+	return func(paging Paging) ([]int, int, error) {
+		*requested = append(*requested, paging)
+		start := (paging.Offset - 1) * paging.Limit
+		if start >= len(items) {
+			return nil, total, nil
+		}
+		end := start + paging.Limit
+		if end > len(items) {
+			end = len(items)
+		}
+		return items[start:end], total, nil
+	}
+}
+
+func collect(it *Iterator[int]) []int {
+	var items []int
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	return items
+}
+
+func TestIteratorPages(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7}
+	cases := []struct {
+		name  string
+		total int
+		pages int
+	}{
+		{"total", len(items), 3},
+		{"missing total", 0, 3},
+	}
+	for _, c := range cases {
+		var requested []Paging
+		it := newIterator(3, pagedFetch(items, c.total, &requested))
+		got := collect(it)
+		if len(got) != len(items) {
+			t.Errorf("%s: expected %d items, got %v", c.name, len(items), got)
+		}
+		if len(requested) != c.pages {
+			t.Errorf("%s: expected %d pages, got %v", c.name, c.pages, requested)
+		}
+		for i, paging := range requested {
+			if paging.Offset != i+1 || paging.Limit != 3 {
+				t.Errorf("%s: unexpected paging %+v for page %d", c.name, paging, i+1)
+			}
+		}
+		if it.Err() != nil {
+			t.Errorf("%s: unexpected error %v", c.name, it.Err())
+		}
+	}
+}
+
+func TestIteratorFullLastPage(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6}
+
+	var requested []Paging
+	if got := collect(newIterator(3, pagedFetch(items, len(items), &requested))); len(got) != 6 || len(requested) != 2 {
+		t.Errorf("with total: expected 6 items from 2 pages, got %v from %d", got, len(requested))
+	}
+
+	// Without a total the iterator cannot tell the last page was full and asks for one more, which comes back empty.
+	requested = nil
+	if got := collect(newIterator(3, pagedFetch(items, 0, &requested))); len(got) != 6 || len(requested) != 3 {
+		t.Errorf("without total: expected 6 items from 3 pages, got %v from %d", got, len(requested))
+	}
+}
+
+func TestIteratorPageError(t *testing.T) {
+	fetchErr := errors.New("boom")
+	calls := 0
+	it := newIterator(2, func(paging Paging) ([]int, int, error) {
+		calls++
+		if paging.Offset == 2 {
+			return nil, 0, fetchErr
+		}
+		return []int{1, 2}, 4, nil
+	})
+	if got := collect(it); len(got) != 2 {
+		t.Errorf("expected the items of the first page, got %v", got)
+	}
+	var pageErr *PageError
+	if !errors.As(it.Err(), &pageErr) || pageErr.Offset != 2 || !errors.Is(it.Err(), fetchErr) {
+		t.Errorf("expected a PageError for page 2 wrapping the fetch error, got %v", it.Err())
+	}
+	if it.Next() || calls != 2 {
+		t.Errorf("expected the iteration to stop after the failed page, %d calls", calls)
+	}
+}
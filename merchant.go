@@ -0,0 +1,284 @@
+package authnet
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type ArrayOfCardType struct {
+	CardType []string `xml:"cardType,omitempty"`
+}
+
+type ProcessorType struct {
+	Name      string           `xml:"name"`
+	Id        int              `xml:"id"`
+	CardTypes *ArrayOfCardType `xml:"cardTypes,omitempty"`
+}
+
+type ArrayOfProcessorType struct {
+	Processor []ProcessorType `xml:"processor,omitempty"`
+}
+
+type ArrayOfMarketType struct {
+	MarketType []string `xml:"marketType,omitempty"`
+}
+
+type ProductCodeType struct {
+	Code        string `xml:"code"`
+	Description string `xml:"description"`
+}
+
+type ArrayOfProductCode struct {
+	ProductCode []ProductCodeType `xml:"productCode,omitempty"`
+}
+
+type ArrayOfPaymentMethod struct {
+	PaymentMethod []string `xml:"paymentMethod,omitempty"`
+}
+
+type ArrayOfCurrencyCode struct {
+	Currency []string `xml:"currency,omitempty"`
+}
+
+type ContactDetailType struct {
+	Email     string `xml:"email,omitempty"`
+	FirstName string `xml:"firstName,omitempty"`
+	LastName  string `xml:"lastName,omitempty"`
+}
+
+type ArrayOfContactDetail struct {
+	ContactDetail []ContactDetailType `xml:"contactDetail,omitempty"`
+}
+
+type GetMerchantDetailsRequest struct {
+	ANetApiRequest
+	XMLName xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getMerchantDetailsRequest"`
+}
+
+type GetMerchantDetailsResponse struct {
+	ANetApiResponse
+	XMLName             xml.Name              `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getMerchantDetailsResponse"`
+	IsTestMode          *bool                 `xml:"isTestMode,omitempty"`
+	Processors          *ArrayOfProcessorType `xml:"processors,omitempty"`
+	MerchantName        string                `xml:"merchantName,omitempty"`
+	GatewayId           string                `xml:"gatewayId,omitempty"`
+	MarketTypes         *ArrayOfMarketType    `xml:"marketTypes,omitempty"`
+	ProductCodes        *ArrayOfProductCode   `xml:"productCodes,omitempty"`
+	PaymentMethods      *ArrayOfPaymentMethod `xml:"paymentMethods,omitempty"`
+	Currencies          *ArrayOfCurrencyCode  `xml:"currencies,omitempty"`
+	PublicClientKey     string                `xml:"publicClientKey,omitempty"`
+	BusinessInformation *CustomerAddressType  `xml:"businessInformation,omitempty"`
+	MerchantTimeZone    string                `xml:"merchantTimeZone,omitempty"`
+	ContactDetails      *ArrayOfContactDetail `xml:"contactDetails,omitempty"`
+}
+
+type UpdateMerchantDetailsRequest struct {
+	ANetApiRequest
+	XMLName    xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd updateMerchantDetailsRequest"`
+	IsTestMode bool     `xml:"isTestMode"`
+}
+
+type UpdateMerchantDetailsResponse struct {
+	ANetApiResponse
+	XMLName xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd updateMerchantDetailsResponse"`
+}
+
+// GetMerchantDetails retrieves the details and the enabled features of the merchant account.
+func (c *AuthNetClient) GetMerchantDetails(req GetMerchantDetailsRequest) (*GetMerchantDetailsResponse, error) {
+	return c.GetMerchantDetailsContext(context.Background(), req)
+}
+
+// GetMerchantDetailsContext is like GetMerchantDetails but uses ctx for the request.
+func (c *AuthNetClient) GetMerchantDetailsContext(ctx context.Context, req GetMerchantDetailsRequest) (*GetMerchantDetailsResponse, error) {
+	var res GetMerchantDetailsResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// UpdateMerchantDetails updates the merchant account, currently only whether it is in test mode.
+func (c *AuthNetClient) UpdateMerchantDetails(req UpdateMerchantDetailsRequest) (*UpdateMerchantDetailsResponse, error) {
+	return c.UpdateMerchantDetailsContext(context.Background(), req)
+}
+
+// UpdateMerchantDetailsContext is like UpdateMerchantDetails but uses ctx for the request.
+func (c *AuthNetClient) UpdateMerchantDetailsContext(ctx context.Context, req UpdateMerchantDetailsRequest) (*UpdateMerchantDetailsResponse, error) {
+	var res UpdateMerchantDetailsResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// The payment methods reported in GetMerchantDetailsResponse.PaymentMethods.
+const (
+	MerchantPaymentMethodECheck = "Echeck"
+	MerchantPaymentMethodPayPal = "Paypal"
+)
+
+// The market types reported in GetMerchantDetailsResponse.MarketTypes.
+const (
+	MerchantMarketTypeECommerce = "eCommerce"
+	MerchantMarketTypeMOTO      = "MOTO"
+	MerchantMarketTypeRetail    = "Retail"
+)
+
+// transRetailMarketTypes maps the TransRetailInfoType.MarketType codes to the market types of the merchant details.
+var transRetailMarketTypes = map[string]string{
+	"0": MerchantMarketTypeECommerce,
+	"1": MerchantMarketTypeMOTO,
+	"2": MerchantMarketTypeRetail,
+}
+
+// Capabilities is a read only view of the features enabled on the merchant account, built from the merchant details.
+type Capabilities struct {
+	Details GetMerchantDetailsResponse
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// SupportsPaymentMethod reports whether a payment method, such as Visa or Echeck, is enabled.
+func (c *Capabilities) SupportsPaymentMethod(method string) bool {
+	return c.Details.PaymentMethods != nil && containsFold(c.Details.PaymentMethods.PaymentMethod, method)
+}
+
+// SupportsECheck reports whether eCheck.Net transactions are enabled.
+func (c *Capabilities) SupportsECheck() bool {
+	return c.SupportsPaymentMethod(MerchantPaymentMethodECheck)
+}
+
+// SupportsCurrency reports whether a currency, such as USD, is enabled.
+func (c *Capabilities) SupportsCurrency(currencyCode string) bool {
+	return c.Details.Currencies != nil && containsFold(c.Details.Currencies.Currency, currencyCode)
+}
+
+// SupportsMarketType reports whether a market type is enabled. Both the names of the merchant details, such as
+// eCommerce, and the codes of TransRetailInfoType.MarketType, such as 2, are accepted.
+func (c *Capabilities) SupportsMarketType(marketType string) bool {
+	if name, ok := transRetailMarketTypes[marketType]; ok {
+		marketType = name
+	}
+	return c.Details.MarketTypes != nil && containsFold(c.Details.MarketTypes.MarketType, marketType)
+}
+
+// CheckTransaction returns an error describing the first feature used by the transaction request that is not enabled
+// on the merchant account.
+func (c *Capabilities) CheckTransaction(req TransactionRequestType) error {
+	if req.Payment != nil && req.Payment.BankAccount != nil && !c.SupportsECheck() {
+		return fmt.Errorf("merchant %s does not support eCheck payments", c.Details.MerchantName)
+	}
+	if req.Payment != nil && req.Payment.PayPal != nil && !c.SupportsPaymentMethod(MerchantPaymentMethodPayPal) {
+		return fmt.Errorf("merchant %s does not support PayPal payments", c.Details.MerchantName)
+	}
+	if len(req.CurrencyCode) > 0 && !c.SupportsCurrency(req.CurrencyCode) {
+		return fmt.Errorf("merchant %s does not support currency %s", c.Details.MerchantName, req.CurrencyCode)
+	}
+	if req.Retail != nil && len(req.Retail.MarketType) > 0 && !c.SupportsMarketType(req.Retail.MarketType) {
+		return fmt.Errorf("merchant %s does not support market type %s", c.Details.MerchantName, req.Retail.MarketType)
+	}
+	return nil
+}
+
+// capabilitiesCache is shared by every copy of an AuthNetClient. The merchant details are requested outside the lock
+// by a single capabilitiesCall that concurrent callers wait on.
+type capabilitiesCache struct {
+	mu           sync.Mutex
+	capabilities *Capabilities
+	call         *capabilitiesCall
+}
+
+// capabilitiesCall is a request of the merchant details in flight. done is closed once capabilities or err is set.
+type capabilitiesCall struct {
+	done         chan struct{}
+	capabilities *Capabilities
+	err          error
+}
+
+// Capabilities returns the features enabled on the merchant account. The merchant details are requested once and
+// cached for the life of the client; use RefreshCapabilities to request them again.
+func (c *AuthNetClient) Capabilities() (*Capabilities, error) {
+	return c.CapabilitiesContext(context.Background())
+}
+
+// CapabilitiesContext is like Capabilities but uses ctx when the merchant details are requested. Concurrent callers
+// share a single request and stop waiting for it when their own ctx is done.
+func (c *AuthNetClient) CapabilitiesContext(ctx context.Context) (*Capabilities, error) {
+	return c.loadCapabilities(ctx, false)
+}
+
+// RefreshCapabilities requests the merchant details again and replaces the cached Capabilities.
+func (c *AuthNetClient) RefreshCapabilities() (*Capabilities, error) {
+	return c.RefreshCapabilitiesContext(context.Background())
+}
+
+// RefreshCapabilitiesContext is like RefreshCapabilities but uses ctx for the request.
+func (c *AuthNetClient) RefreshCapabilitiesContext(ctx context.Context) (*Capabilities, error) {
+	return c.loadCapabilities(ctx, true)
+}
+
+// loadCapabilities returns the cached Capabilities unless refresh is set, otherwise it joins the request in flight or
+// starts one. A caller whose request was abandoned by the context of another caller starts a new one with its own ctx.
+func (c *AuthNetClient) loadCapabilities(ctx context.Context, refresh bool) (*Capabilities, error) {
+	cache := c.capabilities
+	for {
+		cache.mu.Lock()
+		if !refresh && cache.capabilities != nil {
+			capabilities := cache.capabilities
+			cache.mu.Unlock()
+			return capabilities, nil
+		}
+		call := cache.call
+		if call == nil {
+			call = &capabilitiesCall{done: make(chan struct{})}
+			cache.call = call
+			cache.mu.Unlock()
+			c.fetchCapabilities(ctx, call)
+			return call.capabilities, call.err
+		}
+		cache.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return nil, &RequestError{Err: ctx.Err()}
+		case <-call.done:
+		}
+		if call.err == nil {
+			return call.capabilities, nil
+		}
+		var reqErr *RequestError
+		if !errors.As(call.err, &reqErr) || !reqErr.IsContextError() {
+			return nil, call.err
+		}
+	}
+}
+
+func (c *AuthNetClient) fetchCapabilities(ctx context.Context, call *capabilitiesCall) {
+	details, err := c.GetMerchantDetailsContext(ctx, GetMerchantDetailsRequest{
+		ANetApiRequest: ANetApiRequest{
+			MerchantAuthentication: c.CreateMerchantAuthenticationType(),
+		},
+	})
+	if err == nil {
+		call.capabilities = &Capabilities{Details: *details}
+	} else {
+		call.err = err
+	}
+	c.capabilities.mu.Lock()
+	if call.err == nil {
+		c.capabilities.capabilities = call.capabilities
+	}
+	c.capabilities.call = nil
+	c.capabilities.mu.Unlock()
+	close(call.done)
+}
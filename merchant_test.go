@@ -0,0 +1,111 @@
+package authnet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const merchantDetailsResponse = `<?xml version="1.0" encoding="utf-8"?>
+<getMerchantDetailsResponse xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd">
+<messages><resultCode>Ok</resultCode><message><code>I00001</code><text>Successful.</text></message></messages>
+<merchantName>Test Merchant</merchantName>
+<paymentMethods><paymentMethod>Visa</paymentMethod><paymentMethod>Echeck</paymentMethod></paymentMethods>
+<currencies><currency>USD</currency></currencies>
+</getMerchantDetailsResponse>`
+
+func TestCapabilitiesSharesOneRequest(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	client := newFakeClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		w.Write([]byte(merchantDetailsResponse))
+	})
+
+	// A caller giving up does not wait for the request in flight.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	var wg sync.WaitGroup
+	errs := make([]error, 3)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = client.CapabilitiesContext(context.Background())
+		}(i)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if _, err := client.CapabilitiesContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the waiter to give up with its deadline, got %v", err)
+	}
+
+	close(release)
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+	}
+	capabilities, err := client.Capabilities()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !capabilities.SupportsECheck() || !capabilities.SupportsCurrency("usd") || capabilities.SupportsCurrency("EUR") {
+		t.Errorf("unexpected capabilities %+v", capabilities.Details)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("expected a single request, got %d", n)
+	}
+
+	if _, err := client.RefreshCapabilities(); err != nil {
+		t.Fatal(err)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("expected refresh to request the details again, got %d requests", n)
+	}
+}
+
+func TestCapabilitiesRetriesAbandonedRequest(t *testing.T) {
+	var requests atomic.Int32
+	abandoned := make(chan struct{})
+	client := newFakeClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			<-abandoned
+			return
+		}
+		w.Write([]byte(merchantDetailsResponse))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leaderDone := make(chan error)
+	go func() {
+		_, err := client.CapabilitiesContext(ctx)
+		leaderDone <- err
+	}()
+	for requests.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	waiterDone := make(chan error)
+	go func() {
+		_, err := client.CapabilitiesContext(context.Background())
+		waiterDone <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	if err := <-leaderDone; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the canceled caller to fail, got %v", err)
+	}
+	if err := <-waiterDone; err != nil {
+		t.Errorf("expected the waiter to request the details itself, got %v", err)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("expected 2 requests, got %d", n)
+	}
+	close(abandoned)
+}
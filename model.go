@@ -2,6 +2,8 @@ package authnet
 
 import (
 	"encoding/xml"
+	"errors"
+	"fmt"
 	"time"
 )
 
@@ -30,13 +32,11 @@ type FingerPrintType struct {
 // The following fields are mutually exclusive and one is required for request: TransactionKey, SessionToken, Password,
 // ImpersonationAuthentication, FingerPrint, ClientKey, and AccessToken. Validation will check for one of these being
 // set and will result in a validation error if more than one or none are set.
-//
-// The following fields are required: Name and TransactionKey.
 type MerchantAuthenticationType struct {
 	// Name the merchant's unique API Login ID.
 	Name string `xml:"name,omitempty" validation:"max=25"`
 	// TransactionKey the merchant's unique Transaction Key.
-	TransactionKey              string                           `xml:"transactionKey" validation:"required,max=16"`
+	TransactionKey              string                           `xml:"transactionKey,omitempty" validation:"max=16"`
 	SessionToken                string                           `xml:"sessionToken,omitempty" validation:"max=20"`
 	Password                    string                           `xml:"password,omitempty" validation:"max=40"`
 	ImpersonationAuthentication *ImpersonationAuthenticationType `xml:"impersonationAuthentication,omitempty"`
@@ -46,6 +46,19 @@ type MerchantAuthenticationType struct {
 	MobileDeviceId              string                           `xml:"mobileDeviceId,omitempty" validation:"max=60"`
 }
 
+// Validate checks that exactly one authentication mechanism is set.
+func (m MerchantAuthenticationType) Validate() error {
+	return validateChoice(
+		choice{"transactionKey", len(m.TransactionKey) > 0},
+		choice{"sessionToken", len(m.SessionToken) > 0},
+		choice{"password", len(m.Password) > 0},
+		choice{"impersonationAuthentication", m.ImpersonationAuthentication != nil},
+		choice{"fingerPrint", m.FingerPrint != nil},
+		choice{"clientKey", len(m.ClientKey) > 0},
+		choice{"accessToken", len(m.AccessToken) > 0},
+	)
+}
+
 // PaymentType indicates the payment type/method for the transactions.
 //
 // The following fields are mutually exclusive and one is required for request: CreditCard, BankAccount, TrackData,
@@ -62,6 +75,19 @@ type PaymentType struct {
 	DataSource         string                  `xml:"dataSource,omitempty"`
 }
 
+// Validate checks that exactly one payment method is set.
+func (p PaymentType) Validate() error {
+	return validateChoice(
+		choice{"creditCard", p.CreditCard != nil},
+		choice{"bankAccount", p.BankAccount != nil},
+		choice{"trackData", p.TrackData != nil},
+		choice{"encryptedTrackData", p.EncryptedTrackData != nil},
+		choice{"payPal", p.PayPal != nil},
+		choice{"opaqueData", p.OpaqueData != nil},
+		choice{"emv", p.Emv != nil},
+	)
+}
+
 // CreditCardSimpleType defines common properties for a credit card.
 type CreditCardSimpleType struct {
 	// CardNumber format should be numeric string or four X's followed by the last four digits.
@@ -94,8 +120,92 @@ const (
 	AccountTypeBusinessChecking                 = "businessChecking"
 )
 
+type EcheckTypeEnum = string
+
+const (
+	// EcheckTypePPD Prearranged Payment and Deposit, a one time or recurring charge with written authorization.
+	EcheckTypePPD EcheckTypeEnum = "PPD"
+	// EcheckTypeWEB Internet Initiated Entry, authorized by the customer online.
+	EcheckTypeWEB = "WEB"
+	// EcheckTypeCCD Cash Concentration or Disbursement, a charge or refund against a business checking account.
+	EcheckTypeCCD = "CCD"
+	// EcheckTypeTEL Telephone Initiated Entry, authorized by the customer over the phone.
+	EcheckTypeTEL = "TEL"
+	// EcheckTypeARC Accounts Receivable Conversion, a paper check received by mail or drop box.
+	EcheckTypeARC = "ARC"
+	// EcheckTypeBOC Back Office Conversion, a paper check received in person at the point of purchase.
+	EcheckTypeBOC = "BOC"
+)
+
+// BankAccountType is the bank account charged or credited by an eCheck.Net transaction. Validate checks the routing
+// number and the rules of the SEC code in EcheckType. The masked routing and account numbers returned by
+// GetCustomerPaymentProfile are accepted as they are, to update a payment profile without changing them.
 type BankAccountType struct {
 	AccountType AccountTypeEnum `xml:"accountType,omitempty" validation:"oneOf=checking savings businessChecking"`
+	// RoutingNumber is the nine digit ABA routing number of the bank.
+	RoutingNumber string `xml:"routingNumber" validation:"required,masked,numeric,min=9,max=9"`
+	AccountNumber string `xml:"accountNumber" validation:"required,masked,numeric,min=1,max=17"`
+	NameOnAccount string `xml:"nameOnAccount" validation:"required,max=22"`
+	// EcheckType is the SEC code of the transaction, WEB when not set.
+	EcheckType EcheckTypeEnum `xml:"echeckType,omitempty" validation:"oneOf=PPD WEB CCD TEL ARC BOC"`
+	BankName   string         `xml:"bankName,omitempty" validation:"max=50"`
+	// CheckNumber is required for ARC and BOC transactions.
+	CheckNumber string `xml:"checkNumber,omitempty" validation:"numeric,max=15"`
+}
+
+// Validate checks the routing number checksum, unless it is masked, and the account type and check number
+// requirements of the SEC code.
+func (b BankAccountType) Validate() error {
+	var errs []error
+	if !isMasked(b.RoutingNumber) {
+		if routingErr := ValidateRoutingNumber(b.RoutingNumber); routingErr != nil {
+			errs = append(errs, routingErr)
+		}
+	}
+	switch b.EcheckType {
+	case EcheckTypeCCD:
+		if b.AccountType != AccountTypeBusinessChecking {
+			errs = append(errs, errors.New("CCD transactions require a businessChecking account"))
+		}
+	case EcheckTypePPD, EcheckTypeWEB, EcheckTypeTEL, "":
+		if b.AccountType == AccountTypeBusinessChecking {
+			errs = append(errs, fmt.Errorf("%s transactions require a checking or savings account", echeckTypeOrDefault(b.EcheckType)))
+		}
+	case EcheckTypeARC, EcheckTypeBOC:
+		if b.AccountType == AccountTypeSavings {
+			errs = append(errs, fmt.Errorf("%s transactions require a checking or businessChecking account", b.EcheckType))
+		}
+		if len(b.CheckNumber) == 0 {
+			errs = append(errs, fmt.Errorf("%s transactions require a check number", b.EcheckType))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func echeckTypeOrDefault(echeckType EcheckTypeEnum) EcheckTypeEnum {
+	if len(echeckType) == 0 {
+		return EcheckTypeWEB
+	}
+	return echeckType
+}
+
+// ValidateRoutingNumber checks that the routing number is nine digits and passes the ABA checksum.
+func ValidateRoutingNumber(routingNumber string) error {
+	if len(routingNumber) != 9 {
+		return fmt.Errorf("routing number %q must be 9 digits", routingNumber)
+	}
+	weights := [3]int{3, 7, 1}
+	sum := 0
+	for i, digit := range routingNumber {
+		if digit < '0' || digit > '9' {
+			return fmt.Errorf("routing number %q must be 9 digits", routingNumber)
+		}
+		sum += int(digit-'0') * weights[i%3]
+	}
+	if sum%10 != 0 {
+		return fmt.Errorf("routing number %q has an invalid checksum", routingNumber)
+	}
+	return nil
 }
 
 // CreditCardTrackType
@@ -107,6 +217,14 @@ type CreditCardTrackType struct {
 	Track2 string `xml:"track2,omitempty"`
 }
 
+// Validate checks that exactly one of the tracks is set.
+func (t CreditCardTrackType) Validate() error {
+	return validateChoice(
+		choice{"track1", len(t.Track1) > 0},
+		choice{"track2", len(t.Track2) > 0},
+	)
+}
+
 type EncodingType string
 
 const (
@@ -169,7 +287,7 @@ type PaymentProfile struct {
 
 type CustomerProfilePaymentType struct {
 	CreateProfile     *bool           `xml:"createProfile,omitempty"`
-	CustomProfileId   string          `xml:"customProfileId,omitempty" validation:"numeric"`
+	CustomProfileId   string          `xml:"customerProfileId,omitempty" validation:"numeric"`
 	PaymentProfile    *PaymentProfile `xml:"paymentProfile,omitempty"`
 	ShippingProfileId string          `xml:"shippingProfileId,omitempty" validation:"numeric"`
 }
@@ -183,7 +301,7 @@ type SolutionType struct {
 type OrderType struct {
 	InvoiceNumber                  string     `xml:"invoiceNumber" validation:"max=20"`
 	Description                    string     `xml:"description,omitempty" validation:"max=255"`
-	DiscountAmount                 *float64   `xml:"discountAmount,omitempty"`
+	DiscountAmount                 *Amount    `xml:"discountAmount,omitempty"`
 	TaxIsAfterDiscount             *bool      `xml:"taxIsAfterDiscount,omitempty"`
 	TotalTaxTypeCode               string     `xml:"totalTaxTypeCode,omitempty" validation:"max=3"`
 	PurchaserVATRegistrationNumber string     `xml:"purchaserVATRegistrationNumber,omitempty" validation:"max=21"`
@@ -209,34 +327,34 @@ type LineItemType struct {
 	ItemId                  string   `xml:"itemId" validation:"required,min=1,max=31"`
 	Name                    string   `xml:"name" validation:"required,min=1,max=31"`
 	Description             string   `xml:"description,omitempty" validation:"max=255"`
-	Quantity                float64  `xml:"quantity" validation:"required,min=0.00"`
-	UnitPrice               float64  `xml:"unitPrice" validation:"required,min=0.00"`
+	Quantity                float64  `xml:"quantity" validation:"min=0.00"`
+	UnitPrice               Amount   `xml:"unitPrice" validation:"min=0.00"`
 	Taxable                 *bool    `xml:"taxable,omitempty"`
 	UnitOfMeasure           string   `xml:"unitOfMeasure,omitempty" validation:"max=12"`
 	TypeOfSupply            string   `xml:"typeOfSupply,omitempty" validation:"max=2"`
 	TaxRate                 *float64 `xml:"taxRate,omitempty"`
-	TaxAmount               *float64 `xml:"taxAmount,omitempty"`
-	NationalTax             *float64 `xml:"nationalTax,omitempty"`
-	LocalTax                *float64 `xml:"localTax,omitempty"`
+	TaxAmount               *Amount  `xml:"taxAmount,omitempty"`
+	NationalTax             *Amount  `xml:"nationalTax,omitempty"`
+	LocalTax                *Amount  `xml:"localTax,omitempty"`
 	VatRate                 *float64 `xml:"vatRate,omitempty"`
 	AlternateTaxId          string   `xml:"alternateTaxId,omitempty" validation:"max=20"`
 	AlternateTaxTypeApplied *bool    `xml:"alternateTaxTypeApplied,omitempty" validation:"max=4"`
 	AlternateTaxRate        *float64 `xml:"alternateTaxRate,omitempty"`
-	AlternateTaxAmount      *float64 `xml:"alternateTaxAmount,omitempty"`
-	TotalAmount             *float64 `xml:"totalAmount,omitempty"`
+	AlternateTaxAmount      *Amount  `xml:"alternateTaxAmount,omitempty"`
+	TotalAmount             *Amount  `xml:"totalAmount,omitempty"`
 	CommodityCode           string   `xml:"commodityCode,omitempty" validation:"max=15"`
 	ProductCode             string   `xml:"productCode,omitempty" validation:"max=30"`
 	ProductSku              string   `xml:"productSku,omitempty" validation:"max=30"`
 	DiscountRate            *float64 `xml:"discountRate,omitempty"`
-	DiscountAmount          *float64 `xml:"discountAmount,omitempty"`
+	DiscountAmount          *Amount  `xml:"discountAmount,omitempty"`
 	TaxIncludedInTotal      *bool    `xml:"taxIncludedInTotal,omitempty"`
 	TaxIsAfterDiscount      *bool    `xml:"taxIsAfterDiscount,omitempty"`
 }
 
 type ExtendedAmountType struct {
-	Amount      float64 `xml:"amount"`
-	Name        string  `xml:"name,omitempty" validation:"max=31"`
-	Description string  `xml:"description,omitempty" validation:"max=255"`
+	Amount      Amount `xml:"amount"`
+	Name        string `xml:"name,omitempty" validation:"max=31"`
+	Description string `xml:"description,omitempty" validation:"max=255"`
 }
 
 type CustomerTypeEnum string
@@ -254,12 +372,23 @@ type DriversLicenseType struct {
 
 type CustomerDataType struct {
 	Type           *CustomerTypeEnum   `xml:"type,omitempty"`
-	Id             string              `xml:"id,omitempty" validation:"max20"`
+	Id             string              `xml:"id,omitempty" validation:"max=20"`
 	Email          string              `xml:"email,omitempty" validation:"max=255"`
 	DriversLicense *DriversLicenseType `xml:"driversLicense,omitempty"`
 	TaxId          string              `xml:"taxId,omitempty" validation:"min=8,max=9"`
 }
 
+// CustomerType is the customer information used by subscriptions.
+type CustomerType struct {
+	Type           *CustomerTypeEnum   `xml:"type,omitempty"`
+	Id             string              `xml:"id,omitempty" validation:"max=20"`
+	Email          string              `xml:"email,omitempty" validation:"max=255"`
+	PhoneNumber    string              `xml:"phoneNumber,omitempty" validation:"max=25"`
+	FaxNumber      string              `xml:"faxNumber,omitempty" validation:"max=25"`
+	DriversLicense *DriversLicenseType `xml:"driversLicense,omitempty"`
+	TaxId          string              `xml:"taxId,omitempty" validation:"min=8,max=9"`
+}
+
 type CustomerAddressType struct {
 	NameAndAddressType
 	PhoneNumber string `xml:"phoneNumber,omitempty" validation:"max=25"`
@@ -267,8 +396,10 @@ type CustomerAddressType struct {
 	Email       string `xml:"email,omitempty"`
 }
 
+// CustomerAddressExType is a customer address stored in a customer profile, identified by CustomerAddressId.
 type CustomerAddressExType struct {
-	// TODO Implement CustomerAddressExType from scheme
+	CustomerAddressType
+	CustomerAddressId string `xml:"customerAddressId,omitempty" validation:"numeric"`
 }
 
 type NameAndAddressType struct {
@@ -345,17 +476,17 @@ const (
 type SubsequentAuthInformation struct {
 	// TODO Customer validator for alpha numeric space string
 	OriginalNetworkTransId string                      `xml:"originalNetworkTransId,omitempty" validation:"max=255"`
-	OriginalAuthAmount     *float64                    `xml:"originalAuthAmount,omitempty"`
+	OriginalAuthAmount     *Amount                     `xml:"originalAuthAmount,omitempty"`
 	Reason                 MerchantInitTransReasonEnum `xml:"reason,omitempty"`
 }
 
 type OtherTaxType struct {
-	NationalTaxAmount  *float64 `xml:"nationalTaxAmount,omitempty"`
-	LocalTaxAmount     *float64 `xml:"localTaxAmount,omitempty"`
-	AlternateTaxAmount *float64 `xml:"alternateTaxAmount,omitempty"`
+	NationalTaxAmount  *Amount  `xml:"nationalTaxAmount,omitempty"`
+	LocalTaxAmount     *Amount  `xml:"localTaxAmount,omitempty"`
+	AlternateTaxAmount *Amount  `xml:"alternateTaxAmount,omitempty"`
 	AlternateTaxId     string   `xml:"alternateTaxId,omitempty" validation:"max=15"`
 	VatTaxRate         *float64 `xml:"vatTaxRate,omitempty"`
-	VatTaxAmount       *float64 `xml:"vatTaxAmount,omitempty"`
+	VatTaxAmount       *Amount  `xml:"vatTaxAmount,omitempty"`
 }
 
 type AuthIndicatorEnum = string
@@ -372,14 +503,20 @@ type AuthorizationIndicatorType struct {
 type TransactionTypeEnum = string
 
 const (
-	TransactionTypePriorAuthCaptureTransaction TransactionTypeEnum = "priorAuthCaptureTransaction"
-	TransactionTypeAuthOnlyTransaction                             = "authOnlyTransaction"
-	TransactionTypeAuthCaptureTransaction                          = "authCaptureTransaction"
+	TransactionTypePriorAuthCaptureTransaction    TransactionTypeEnum = "priorAuthCaptureTransaction"
+	TransactionTypeAuthOnlyTransaction                                = "authOnlyTransaction"
+	TransactionTypeAuthCaptureTransaction                             = "authCaptureTransaction"
+	TransactionTypeCaptureOnlyTransaction                             = "captureOnlyTransaction"
+	TransactionTypeRefundTransaction                                  = "refundTransaction"
+	TransactionTypeVoidTransaction                                    = "voidTransaction"
+	TransactionTypeGetDetailsTransaction                              = "getDetailsTransaction"
+	TransactionTypeAuthOnlyContinueTransaction                        = "authOnlyContinueTransaction"
+	TransactionTypeAuthCaptureContinueTransaction                     = "authCaptureContinueTransaction"
 )
 
 type TransactionRequestType struct {
 	TransactionType            TransactionTypeEnum         `xml:"transactionType,omitempty"`
-	Amount                     *float64                    `xml:"amount,omitempty"`
+	Amount                     *Amount                     `xml:"amount,omitempty"`
 	CurrencyCode               string                      `xml:"currencyCode,omitempty"`
 	Payment                    *PaymentType                `xml:"payment,omitempty"`
 	Profile                    *CustomerProfilePaymentType `xml:"profile,omitempty"`
@@ -435,9 +572,9 @@ type ANetApiResponse struct {
 }
 
 type PrePaidCard struct {
-	RequestAmount  string `xml:"requestAmount,omitempty"`
-	ApprovedAmount string `xml:"approvedAmount,omitempty"`
-	BalanceOnCard  string `xml:"balanceOnCard,omitempty"`
+	RequestAmount  *Amount `xml:"requestAmount,omitempty"`
+	ApprovedAmount *Amount `xml:"approvedAmount,omitempty"`
+	BalanceOnCard  *Amount `xml:"balanceOnCard,omitempty"`
 }
 
 type Error struct {
@@ -450,15 +587,15 @@ type Errors struct {
 }
 
 type SplitTenderPayment struct {
-	TransId            string `xml:"transId,omitempty"`
-	ResponseCode       string `xml:"responseCode,omitempty"`
-	ResponseToCustomer string `xml:"responseToCustomer,omitempty"`
-	AuthCode           string `xml:"authCode,omitempty"`
-	AccountNumber      string `xml:"accountNumber,omitempty"`
-	AccountType        string `xml:"accountType,omitempty"`
-	RequestAmount      string `xml:"requestAmount,omitempty"`
-	ApprovedAmount     string `xml:"approvedAmount,omitempty"`
-	BalanceOnCard      string `xml:"balanceOnCard,omitempty"`
+	TransId            string  `xml:"transId,omitempty"`
+	ResponseCode       string  `xml:"responseCode,omitempty"`
+	ResponseToCustomer string  `xml:"responseToCustomer,omitempty"`
+	AuthCode           string  `xml:"authCode,omitempty"`
+	AccountNumber      string  `xml:"accountNumber,omitempty"`
+	AccountType        string  `xml:"accountType,omitempty"`
+	RequestAmount      *Amount `xml:"requestAmount,omitempty"`
+	ApprovedAmount     *Amount `xml:"approvedAmount,omitempty"`
+	BalanceOnCard      *Amount `xml:"balanceOnCard,omitempty"`
 }
 
 type SplitTenderPayments struct {
@@ -520,7 +657,13 @@ type TransactionResponse struct {
 	NetworkTransId string `xml:"networkTransId,omitempty" validation:"max=255"`
 }
 
+// CreateProfileResponse is the result of creating a customer profile while processing a transaction with
+// CustomerProfilePaymentType.CreateProfile set.
 type CreateProfileResponse struct {
+	Messages                      *MessagesType       `xml:"messages,omitempty"`
+	CustomerProfileId             string              `xml:"customerProfileId,omitempty"`
+	CustomerPaymentProfileIdList  *NumericStringsType `xml:"customerPaymentProfileIdList,omitempty"`
+	CustomerShippingAddressIdList *NumericStringsType `xml:"customerShippingAddressIdList,omitempty"`
 }
 
 type CreateTransactionRequestType struct {
@@ -577,12 +720,139 @@ type GetCustomerProfileRequest struct {
 	IncludeIssuerInfo    *bool    `xml:"includeIssuerInfo,omitempty"`
 }
 
+// CustomerProfileBaseType defines the properties shared by every customer profile representation.
+type CustomerProfileBaseType struct {
+	MerchantCustomerId string `xml:"merchantCustomerId,omitempty" validation:"max=20"`
+	Description        string `xml:"description,omitempty" validation:"max=255"`
+	Email              string `xml:"email,omitempty" validation:"max=255"`
+}
+
+// CustomerProfileType is the customer profile used when creating a new profile. At least one of MerchantCustomerId,
+// Description or Email is required by the gateway.
+type CustomerProfileType struct {
+	CustomerProfileBaseType
+	PaymentProfiles []CustomerPaymentProfileType `xml:"paymentProfiles,omitempty"`
+	ShipToList      []CustomerAddressType        `xml:"shipToList,omitempty"`
+	ProfileType     CustomerProfileTypeEnum      `xml:"profileType,omitempty"`
+}
+
+// CustomerProfileExType is the customer profile used when updating an existing profile.
 type CustomerProfileExType struct {
+	CustomerProfileBaseType
 	CustomerProfileId string `xml:"customerProfileId,omitempty" validation:"numeric"`
 }
 
+// CustomerPaymentProfileBaseType defines the properties shared by every customer payment profile representation.
+type CustomerPaymentProfileBaseType struct {
+	CustomerType *CustomerTypeEnum    `xml:"customerType,omitempty"`
+	BillTo       *CustomerAddressType `xml:"billTo,omitempty"`
+}
+
+// CustomerPaymentProfileType is the customer payment profile used when creating a new payment profile.
+type CustomerPaymentProfileType struct {
+	CustomerPaymentProfileBaseType
+	Payment                   *PaymentType               `xml:"payment,omitempty"`
+	DriversLicense            *DriversLicenseType        `xml:"driversLicense,omitempty"`
+	TaxId                     string                     `xml:"taxId,omitempty" validation:"min=8,max=9"`
+	DefaultPaymentProfile     *bool                      `xml:"defaultPaymentProfile,omitempty"`
+	SubsequentAuthInformation *SubsequentAuthInformation `xml:"subsequentAuthInformation,omitempty"`
+}
+
+type ValidationModeEnum = string
+
+const (
+	ValidationModeNone        ValidationModeEnum = "none"
+	ValidationModeTestMode                       = "testMode"
+	ValidationModeLiveMode                       = "liveMode"
+	ValidationModeOldLiveMode                    = "oldLiveMode"
+)
+
+type NumericStringsType struct {
+	NumericString []string `xml:"numericString,omitempty" validation:"numeric"`
+}
+
+type ArrayOfString struct {
+	String []string `xml:"string,omitempty"`
+}
+
+// CustomerPaymentProfileExType is the customer payment profile used when updating an existing payment profile.
+type CustomerPaymentProfileExType struct {
+	CustomerPaymentProfileType
+	CustomerPaymentProfileId string `xml:"customerPaymentProfileId,omitempty" validation:"numeric"`
+}
+
+type CardArt struct {
+	CardBrand       string `xml:"cardBrand,omitempty"`
+	CardImageHeight string `xml:"cardImageHeight,omitempty"`
+	CardImageUrl    string `xml:"cardImageUrl,omitempty"`
+	CardImageWidth  string `xml:"cardImageWidth,omitempty"`
+	CardType        string `xml:"cardType,omitempty"`
+}
+
+// CreditCardMaskedType is the credit card information returned by the gateway with all but the last four digits of
+// the card number masked.
+type CreditCardMaskedType struct {
+	CardNumber     string   `xml:"cardNumber"`
+	ExpirationDate string   `xml:"expirationDate"`
+	CardType       string   `xml:"cardType,omitempty"`
+	CardArt        *CardArt `xml:"cardArt,omitempty"`
+	// IssuerNumber is only returned when the issuer information was requested.
+	IssuerNumber   string `xml:"issuerNumber,omitempty"`
+	IsPaymentToken *bool  `xml:"isPaymentToken,omitempty"`
+}
+
+// BankAccountMaskedType is the bank account information returned by the gateway with all but the last four digits of
+// the routing and account numbers masked.
+type BankAccountMaskedType struct {
+	AccountType   AccountTypeEnum `xml:"accountType,omitempty"`
+	RoutingNumber string          `xml:"routingNumber"`
+	AccountNumber string          `xml:"accountNumber"`
+	NameOnAccount string          `xml:"nameOnAccount"`
+	EcheckType    EcheckTypeEnum  `xml:"echeckType,omitempty"`
+	BankName      string          `xml:"bankName,omitempty"`
+}
+
+type TokenMaskedType struct {
+	TokenSource      string `xml:"tokenSource,omitempty"`
+	TokenNumber      string `xml:"tokenNumber"`
+	ExpirationDate   string `xml:"expirationDate"`
+	TokenRequestorId string `xml:"tokenRequestorId,omitempty"`
+}
+
+// PaymentMaskedType is the masked counterpart of PaymentType returned by the gateway. Only one of the fields is set.
+type PaymentMaskedType struct {
+	CreditCard       *CreditCardMaskedType  `xml:"creditCard,omitempty"`
+	BankAccount      *BankAccountMaskedType `xml:"bankAccount,omitempty"`
+	TokenInformation *TokenMaskedType       `xml:"tokenInformation,omitempty"`
+}
+
+type DriversLicenseMaskedType struct {
+	Number      string `xml:"number"`
+	State       string `xml:"state"`
+	DateOfBirth string `xml:"dateOfBirth"`
+}
+
+// CustomerPaymentProfileMaskedType is the customer payment profile returned by the gateway with the sensitive payment
+// information masked.
 type CustomerPaymentProfileMaskedType struct {
-	// TODO Implement CustomerPaymentProfileMaskedType from scheme
+	CustomerPaymentProfileBaseType
+	CustomerProfileId         string                    `xml:"customerProfileId,omitempty"`
+	CustomerPaymentProfileId  string                    `xml:"customerPaymentProfileId"`
+	DefaultPaymentProfile     *bool                     `xml:"defaultPaymentProfile,omitempty"`
+	Payment                   *PaymentMaskedType        `xml:"payment,omitempty"`
+	DriversLicense            *DriversLicenseMaskedType `xml:"driversLicense,omitempty"`
+	TaxId                     string                    `xml:"taxId,omitempty"`
+	SubscriptionIds           *SubscriptionIdList       `xml:"subscriptionIds,omitempty"`
+	OriginalNetworkTransId    string                    `xml:"originalNetworkTransId,omitempty"`
+	OriginalAuthAmount        *Amount                   `xml:"originalAuthAmount,omitempty"`
+	ExcludeFromAccountUpdater *bool                     `xml:"excludeFromAccountUpdater,omitempty"`
+}
+
+// Paging restricts list requests to a single page of results. Offset is the page number starting at 1, not the
+// number of items to skip.
+type Paging struct {
+	Limit  int `xml:"limit" validation:"required,min=1,max=1000"`
+	Offset int `xml:"offset" validation:"required,min=1,max=100000"`
 }
 
 type CustomerProfileTypeEnum = string
@@ -594,7 +864,7 @@ const (
 
 type CustomerProfileMaskedType struct {
 	CustomerProfileExType
-	PaymentProfiles []CustomerPaymentProfileMaskedType `xml:"paymentProfile,omitempty"`
+	PaymentProfiles []CustomerPaymentProfileMaskedType `xml:"paymentProfiles,omitempty"`
 	ShipToList      []CustomerAddressExType            `xml:"shipToList,omitempty"`
 	ProfileType     *CustomerProfileTypeEnum           `xml:"profileType,omitempty"`
 }
@@ -605,6 +875,7 @@ type SubscriptionIdList struct {
 
 type GetCustomerProfileResponse struct {
 	ANetApiResponse
-	XMLName         xml.Name            `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getCustomerProfileResponse"`
-	SubscriptionIds *SubscriptionIdList `xml:"subscriptionIds,omitempty"`
+	XMLName         xml.Name                   `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getCustomerProfileResponse"`
+	Profile         *CustomerProfileMaskedType `xml:"profile,omitempty"`
+	SubscriptionIds *SubscriptionIdList        `xml:"subscriptionIds,omitempty"`
 }
@@ -0,0 +1,166 @@
+package authnet
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateRoutingNumber(t *testing.T) {
+	cases := []struct {
+		routingNumber string
+		valid         bool
+	}{
+		{"011000015", true},
+		{"021000021", true},
+		{"121000358", true},
+		{"021000022", false},
+		{"121000359", false},
+		{"02100002", false},
+		{"0210000210", false},
+		{"02100002a", false},
+		{"", false},
+	}
+	for _, c := range cases {
+		if err := ValidateRoutingNumber(c.routingNumber); (err == nil) != c.valid {
+			t.Errorf("%q: expected valid %t, got %v", c.routingNumber, c.valid, err)
+		}
+	}
+}
+
+func TestBankAccountTypeValidate(t *testing.T) {
+	cases := []struct {
+		name        string
+		echeckType  EcheckTypeEnum
+		accountType AccountTypeEnum
+		checkNumber string
+		routing     string
+		errs        int
+	}{
+		{"default checking", "", AccountTypeChecking, "", "021000021", 0},
+		{"default business", "", AccountTypeBusinessChecking, "", "021000021", 1},
+		{"WEB savings", EcheckTypeWEB, AccountTypeSavings, "", "021000021", 0},
+		{"PPD business", EcheckTypePPD, AccountTypeBusinessChecking, "", "021000021", 1},
+		{"TEL business", EcheckTypeTEL, AccountTypeBusinessChecking, "", "021000021", 1},
+		{"CCD business", EcheckTypeCCD, AccountTypeBusinessChecking, "", "021000021", 0},
+		{"CCD checking", EcheckTypeCCD, AccountTypeChecking, "", "021000021", 1},
+		{"ARC checking", EcheckTypeARC, AccountTypeChecking, "1234", "021000021", 0},
+		{"BOC business", EcheckTypeBOC, AccountTypeBusinessChecking, "1234", "021000021", 0},
+		{"ARC without check number", EcheckTypeARC, AccountTypeChecking, "", "021000021", 1},
+		{"BOC savings without check number", EcheckTypeBOC, AccountTypeSavings, "", "021000021", 2},
+		{"invalid routing number", EcheckTypeWEB, AccountTypeChecking, "", "021000022", 1},
+		{"invalid routing number and CCD savings", EcheckTypeCCD, AccountTypeSavings, "", "02100002", 2},
+	}
+	for _, c := range cases {
+		err := BankAccountType{
+			AccountType:   c.accountType,
+			RoutingNumber: c.routing,
+			AccountNumber: "123456789",
+			NameOnAccount: "John Doe",
+			EcheckType:    c.echeckType,
+			CheckNumber:   c.checkNumber,
+		}.Validate()
+		errs := 0
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			errs = len(joined.Unwrap())
+		} else if err != nil {
+			errs = 1
+		}
+		if errs != c.errs {
+			t.Errorf("%s: expected %d errors, got %v", c.name, c.errs, err)
+		}
+	}
+}
+
+func TestBankAccountTypeValidateThroughRequest(t *testing.T) {
+	err := Validate(PaymentType{BankAccount: &BankAccountType{
+		AccountType:   AccountTypeSavings,
+		RoutingNumber: "021000021",
+		AccountNumber: "123456789",
+		NameOnAccount: "John Doe",
+		EcheckType:    EcheckTypeCCD,
+	}})
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) || len(validationErr.Errors) != 1 || validationErr.Errors[0].Field != "bankAccount" {
+		t.Errorf("expected a single error for bankAccount, got %v", err)
+	}
+}
+
+func TestBankAccountTypeAcceptsMaskedNumbers(t *testing.T) {
+	masked := PaymentType{BankAccount: &BankAccountType{
+		AccountType:   AccountTypeChecking,
+		RoutingNumber: "XXXX0021",
+		AccountNumber: "XXXX6789",
+		NameOnAccount: "John Doe",
+	}}
+	if err := Validate(masked); err != nil {
+		t.Errorf("expected masked numbers to be valid, got %v", err)
+	}
+
+	masked.BankAccount.RoutingNumber, masked.BankAccount.AccountNumber = "0021XXXX", "6789X"
+	var validationErr *ValidationError
+	if err := Validate(masked); !errors.As(err, &validationErr) || len(validationErr.Errors) != 4 {
+		t.Errorf("expected numbers only masked at the end to be rejected, got %v", err)
+	}
+}
+
+func TestValidateChoice(t *testing.T) {
+	cases := []struct {
+		name    string
+		options []choice
+		message string
+	}{
+		{"none", []choice{{"a", false}, {"b", false}}, "one of a, b must be set"},
+		{"one", []choice{{"a", false}, {"b", true}}, ""},
+		{"two", []choice{{"a", true}, {"b", true}, {"c", false}}, "only one of a, b, c may be set, found a and b"},
+	}
+	for _, c := range cases {
+		err := validateChoice(c.options...)
+		if message := errorMessage(err); message != c.message {
+			t.Errorf("%s: expected %q, got %q", c.name, c.message, message)
+		}
+	}
+}
+
+func TestChoiceValidators(t *testing.T) {
+	cases := []struct {
+		name      string
+		validator Validator
+		message   string
+	}{
+		{"no authentication", MerchantAuthenticationType{Name: "login"}, "one of transactionKey, sessionToken, password, impersonationAuthentication, fingerPrint, clientKey, accessToken must be set"},
+		{"transaction key", MerchantAuthenticationType{Name: "login", TransactionKey: "key"}, ""},
+		{"transaction key and session token", MerchantAuthenticationType{TransactionKey: "key", SessionToken: "token"}, "only one of transactionKey, sessionToken, password, impersonationAuthentication, fingerPrint, clientKey, accessToken may be set, found transactionKey and sessionToken"},
+		{"no payment", PaymentType{DataSource: "INAPP"}, "one of creditCard, bankAccount, trackData, encryptedTrackData, payPal, opaqueData, emv must be set"},
+		{"credit card", PaymentType{CreditCard: &CreditCardType{}}, ""},
+		{"credit card and opaque data", PaymentType{CreditCard: &CreditCardType{}, OpaqueData: &OpaqueDataType{}}, "only one of creditCard, bankAccount, trackData, encryptedTrackData, payPal, opaqueData, emv may be set, found creditCard and opaqueData"},
+		{"no track", CreditCardTrackType{}, "one of track1, track2 must be set"},
+		{"track2", CreditCardTrackType{Track2: ";4111111111111111=2512?"}, ""},
+		{"both tracks", CreditCardTrackType{Track1: "%B4111", Track2: ";4111"}, "only one of track1, track2 may be set, found track1 and track2"},
+	}
+	for _, c := range cases {
+		if message := errorMessage(c.validator.Validate()); message != c.message {
+			t.Errorf("%s: expected %q, got %q", c.name, c.message, message)
+		}
+	}
+}
+
+func TestChoiceValidatorThroughRequest(t *testing.T) {
+	err := Validate(TransactionRequestType{Payment: &PaymentType{}})
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected a *ValidationError, got %v", err)
+	}
+	for _, fieldErr := range validationErr.Errors {
+		if fieldErr.Field == "payment" {
+			return
+		}
+	}
+	t.Errorf("expected an error for payment, got %v", err)
+}
+
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,177 @@
+package authnet
+
+import "strconv"
+
+type TransactionOutcomeEnum = string
+
+const (
+	// TransactionOutcomeApproved the transaction was approved, response code 1.
+	TransactionOutcomeApproved TransactionOutcomeEnum = "approved"
+	// TransactionOutcomeDeclined the transaction was declined by the issuer or a fraud filter, response code 2.
+	TransactionOutcomeDeclined = "declined"
+	// TransactionOutcomeError the transaction could not be processed, response code 3.
+	TransactionOutcomeError = "error"
+	// TransactionOutcomeHeldForReview the transaction was held by a fraud filter for review, response code 4.
+	TransactionOutcomeHeldForReview = "heldForReview"
+	// TransactionOutcomeUnknown the response code is missing or not one of the documented values.
+	TransactionOutcomeUnknown = "unknown"
+)
+
+var transactionOutcomes = map[string]TransactionOutcomeEnum{
+	"1": TransactionOutcomeApproved,
+	"2": TransactionOutcomeDeclined,
+	"3": TransactionOutcomeError,
+	"4": TransactionOutcomeHeldForReview,
+}
+
+// TransactionOutcome maps a transaction response code to its outcome.
+func TransactionOutcome(responseCode string) TransactionOutcomeEnum {
+	if outcome, ok := transactionOutcomes[responseCode]; ok {
+		return outcome
+	}
+	return TransactionOutcomeUnknown
+}
+
+type MatchResultEnum = string
+
+const (
+	// MatchResultMatch the submitted value matches the issuer's records.
+	MatchResultMatch MatchResultEnum = "match"
+	// MatchResultNoMatch the submitted value does not match the issuer's records.
+	MatchResultNoMatch = "noMatch"
+	// MatchResultUnavailable the value was not submitted or could not be checked.
+	MatchResultUnavailable = "unavailable"
+)
+
+// AVSResult is the decoded Address Verification Service response code.
+type AVSResult struct {
+	Code        string
+	Description string
+	Address     MatchResultEnum
+	Zip         MatchResultEnum
+}
+
+var avsResults = map[string]AVSResult{
+	"A": {Description: "The street address matched, but the postal code did not.", Address: MatchResultMatch, Zip: MatchResultNoMatch},
+	"B": {Description: "No address information was provided.", Address: MatchResultUnavailable, Zip: MatchResultUnavailable},
+	"E": {Description: "The AVS check returned an error.", Address: MatchResultUnavailable, Zip: MatchResultUnavailable},
+	"G": {Description: "The card was issued by a bank outside the U.S. and does not support AVS.", Address: MatchResultUnavailable, Zip: MatchResultUnavailable},
+	"N": {Description: "Neither the street address nor postal code matched.", Address: MatchResultNoMatch, Zip: MatchResultNoMatch},
+	"P": {Description: "AVS is not applicable for this transaction.", Address: MatchResultUnavailable, Zip: MatchResultUnavailable},
+	"R": {Description: "Retry, the AVS was unavailable or timed out.", Address: MatchResultUnavailable, Zip: MatchResultUnavailable},
+	"S": {Description: "AVS is not supported by the card issuer.", Address: MatchResultUnavailable, Zip: MatchResultUnavailable},
+	"U": {Description: "Address information is not available.", Address: MatchResultUnavailable, Zip: MatchResultUnavailable},
+	"W": {Description: "The 9 digit postal code matched, but the street address did not.", Address: MatchResultNoMatch, Zip: MatchResultMatch},
+	"X": {Description: "Both the street address and 9 digit postal code matched.", Address: MatchResultMatch, Zip: MatchResultMatch},
+	"Y": {Description: "Both the street address and 5 digit postal code matched.", Address: MatchResultMatch, Zip: MatchResultMatch},
+	"Z": {Description: "The 5 digit postal code matched, but the street address did not.", Address: MatchResultNoMatch, Zip: MatchResultMatch},
+}
+
+// DecodeAVS decodes an AVS response code. Unknown codes are reported as unavailable.
+func DecodeAVS(code string) AVSResult {
+	result, ok := avsResults[code]
+	if !ok {
+		result = AVSResult{Description: "Unknown AVS response code.", Address: MatchResultUnavailable, Zip: MatchResultUnavailable}
+	}
+	result.Code = code
+	return result
+}
+
+// CVVResult is the decoded card code verification response code.
+type CVVResult struct {
+	Code        string
+	Description string
+	Match       MatchResultEnum
+}
+
+var cvvResults = map[string]CVVResult{
+	"M": {Description: "The card code matched.", Match: MatchResultMatch},
+	"N": {Description: "The card code did not match.", Match: MatchResultNoMatch},
+	"P": {Description: "The card code was not processed.", Match: MatchResultUnavailable},
+	"S": {Description: "The card code should be on the card, but was not indicated.", Match: MatchResultUnavailable},
+	"U": {Description: "The card issuer was unable to process the card code verification.", Match: MatchResultUnavailable},
+}
+
+// DecodeCVV decodes a card code verification response code. Unknown codes are reported as unavailable.
+func DecodeCVV(code string) CVVResult {
+	result, ok := cvvResults[code]
+	if !ok {
+		result = CVVResult{Description: "The card code was not checked.", Match: MatchResultUnavailable}
+	}
+	result.Code = code
+	return result
+}
+
+// CAVVResult is the decoded Cardholder Authentication Verification Value response code of a 3-D Secure transaction.
+// Attempt is set when the cardholder was not enrolled and the issuer or card brand attempted the authentication.
+type CAVVResult struct {
+	Code        string
+	Description string
+	Match       MatchResultEnum
+	Attempt     bool
+}
+
+var cavvResults = map[string]CAVVResult{
+	"0": {Description: "CAVV was not validated because erroneous data was submitted.", Match: MatchResultUnavailable},
+	"1": {Description: "CAVV failed validation.", Match: MatchResultNoMatch},
+	"2": {Description: "CAVV passed validation.", Match: MatchResultMatch},
+	"3": {Description: "CAVV validation could not be performed, the issuer attempt was incomplete.", Match: MatchResultUnavailable},
+	"4": {Description: "CAVV validation could not be performed, the issuer had a system error.", Match: MatchResultUnavailable},
+	"7": {Description: "CAVV attempt failed validation, the issuer was available.", Match: MatchResultNoMatch, Attempt: true},
+	"8": {Description: "CAVV attempt passed validation, the issuer was available.", Match: MatchResultMatch, Attempt: true},
+	"9": {Description: "CAVV attempt failed validation, the issuer was unavailable.", Match: MatchResultNoMatch, Attempt: true},
+	"A": {Description: "CAVV attempt passed validation, the issuer was unavailable.", Match: MatchResultMatch, Attempt: true},
+	"B": {Description: "CAVV passed validation, information only with no liability shift.", Match: MatchResultMatch},
+}
+
+// DecodeCAVV decodes a CAVV response code. An empty code means the CAVV was not validated.
+func DecodeCAVV(code string) CAVVResult {
+	result, ok := cavvResults[code]
+	if !ok {
+		result = CAVVResult{Description: "CAVV was not validated.", Match: MatchResultUnavailable}
+	}
+	result.Code = code
+	return result
+}
+
+// Outcome returns the outcome of the submitted transaction from ResponseCode. It is TransactionOutcomeUnknown when the
+// response holds no transactionResponse.
+func (r *TransactionResponse) Outcome() TransactionOutcomeEnum {
+	return TransactionOutcome(r.ResponseCode)
+}
+
+// AVS decodes AvsResultCode.
+func (r *TransactionResponse) AVS() AVSResult {
+	return DecodeAVS(r.AvsResultCode)
+}
+
+// CVV decodes CvvResultCode.
+func (r *TransactionResponse) CVV() CVVResult {
+	return DecodeCVV(r.CvvResultCode)
+}
+
+// CAVV decodes CavvResultCode.
+func (r *TransactionResponse) CAVV() CAVVResult {
+	return DecodeCAVV(r.CavvResultCode)
+}
+
+// Outcome returns the outcome of the transaction when it was submitted. Later changes, such as a void or the
+// settlement, are reported by TransactionStatus instead.
+func (t *TransactionDetailsType) Outcome() TransactionOutcomeEnum {
+	return TransactionOutcome(strconv.Itoa(t.ResponseCode))
+}
+
+// AVS decodes AVSResponse.
+func (t *TransactionDetailsType) AVS() AVSResult {
+	return DecodeAVS(t.AVSResponse)
+}
+
+// CVV decodes CardCodeResponse.
+func (t *TransactionDetailsType) CVV() CVVResult {
+	return DecodeCVV(t.CardCodeResponse)
+}
+
+// CAVV decodes CAVVResponse.
+func (t *TransactionDetailsType) CAVV() CAVVResult {
+	return DecodeCAVV(t.CAVVResponse)
+}
@@ -0,0 +1,125 @@
+package authnet
+
+import "testing"
+
+func TestTransactionOutcome(t *testing.T) {
+	cases := []struct {
+		responseCode string
+		outcome      TransactionOutcomeEnum
+	}{
+		{"1", TransactionOutcomeApproved},
+		{"2", TransactionOutcomeDeclined},
+		{"3", TransactionOutcomeError},
+		{"4", TransactionOutcomeHeldForReview},
+		{"", TransactionOutcomeUnknown},
+		{"0", TransactionOutcomeUnknown},
+		{"5", TransactionOutcomeUnknown},
+		{"01", TransactionOutcomeUnknown},
+	}
+	for _, c := range cases {
+		if outcome := TransactionOutcome(c.responseCode); outcome != c.outcome {
+			t.Errorf("%q: expected %s, got %s", c.responseCode, c.outcome, outcome)
+		}
+	}
+
+	if outcome := (&TransactionResponse{ResponseCode: "2"}).Outcome(); outcome != TransactionOutcomeDeclined {
+		t.Errorf("TransactionResponse: expected declined, got %s", outcome)
+	}
+	if outcome := (&TransactionResponse{}).Outcome(); outcome != TransactionOutcomeUnknown {
+		t.Errorf("empty TransactionResponse: expected unknown, got %s", outcome)
+	}
+	if outcome := (&TransactionDetailsType{ResponseCode: 4}).Outcome(); outcome != TransactionOutcomeHeldForReview {
+		t.Errorf("TransactionDetailsType: expected heldForReview, got %s", outcome)
+	}
+	if outcome := (&TransactionDetailsType{}).Outcome(); outcome != TransactionOutcomeUnknown {
+		t.Errorf("empty TransactionDetailsType: expected unknown, got %s", outcome)
+	}
+}
+
+func TestDecodeAVS(t *testing.T) {
+	cases := []struct {
+		code    string
+		address MatchResultEnum
+		zip     MatchResultEnum
+	}{
+		{"Y", MatchResultMatch, MatchResultMatch},
+		{"X", MatchResultMatch, MatchResultMatch},
+		{"A", MatchResultMatch, MatchResultNoMatch},
+		{"Z", MatchResultNoMatch, MatchResultMatch},
+		{"W", MatchResultNoMatch, MatchResultMatch},
+		{"N", MatchResultNoMatch, MatchResultNoMatch},
+		{"P", MatchResultUnavailable, MatchResultUnavailable},
+		{"", MatchResultUnavailable, MatchResultUnavailable},
+		{"Q", MatchResultUnavailable, MatchResultUnavailable},
+	}
+	for _, c := range cases {
+		result := DecodeAVS(c.code)
+		if result.Code != c.code || result.Address != c.address || result.Zip != c.zip || len(result.Description) == 0 {
+			t.Errorf("%q: expected address %s and zip %s, got %+v", c.code, c.address, c.zip, result)
+		}
+	}
+	if result := (&TransactionResponse{AvsResultCode: "Y"}).AVS(); result.Address != MatchResultMatch {
+		t.Errorf("TransactionResponse: unexpected %+v", result)
+	}
+	if result := (&TransactionDetailsType{AVSResponse: "N"}).AVS(); result.Zip != MatchResultNoMatch {
+		t.Errorf("TransactionDetailsType: unexpected %+v", result)
+	}
+}
+
+func TestDecodeCVV(t *testing.T) {
+	cases := []struct {
+		code  string
+		match MatchResultEnum
+	}{
+		{"M", MatchResultMatch},
+		{"N", MatchResultNoMatch},
+		{"P", MatchResultUnavailable},
+		{"S", MatchResultUnavailable},
+		{"U", MatchResultUnavailable},
+		{"", MatchResultUnavailable},
+		{"X", MatchResultUnavailable},
+	}
+	for _, c := range cases {
+		result := DecodeCVV(c.code)
+		if result.Code != c.code || result.Match != c.match || len(result.Description) == 0 {
+			t.Errorf("%q: expected %s, got %+v", c.code, c.match, result)
+		}
+	}
+	if result := (&TransactionResponse{CvvResultCode: "N"}).CVV(); result.Match != MatchResultNoMatch {
+		t.Errorf("TransactionResponse: unexpected %+v", result)
+	}
+	if result := (&TransactionDetailsType{CardCodeResponse: "M"}).CVV(); result.Match != MatchResultMatch {
+		t.Errorf("TransactionDetailsType: unexpected %+v", result)
+	}
+}
+
+func TestDecodeCAVV(t *testing.T) {
+	cases := []struct {
+		code    string
+		match   MatchResultEnum
+		attempt bool
+	}{
+		{"2", MatchResultMatch, false},
+		{"B", MatchResultMatch, false},
+		{"1", MatchResultNoMatch, false},
+		{"0", MatchResultUnavailable, false},
+		{"8", MatchResultMatch, true},
+		{"A", MatchResultMatch, true},
+		{"7", MatchResultNoMatch, true},
+		{"9", MatchResultNoMatch, true},
+		{"", MatchResultUnavailable, false},
+		{"Z", MatchResultUnavailable, false},
+	}
+	for _, c := range cases {
+		result := DecodeCAVV(c.code)
+		if result.Code != c.code || result.Match != c.match || result.Attempt != c.attempt || len(result.Description) == 0 {
+			t.Errorf("%q: expected %s attempt %t, got %+v", c.code, c.match, c.attempt, result)
+		}
+	}
+	if result := (&TransactionResponse{CavvResultCode: "2"}).CAVV(); result.Match != MatchResultMatch {
+		t.Errorf("TransactionResponse: unexpected %+v", result)
+	}
+	if result := (&TransactionDetailsType{CAVVResponse: "1"}).CAVV(); result.Match != MatchResultNoMatch {
+		t.Errorf("TransactionDetailsType: unexpected %+v", result)
+	}
+}
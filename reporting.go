@@ -0,0 +1,396 @@
+package authnet
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+type TransactionStatusEnum = string
+
+const (
+	TransactionStatusAuthorizedPendingCapture   TransactionStatusEnum = "authorizedPendingCapture"
+	TransactionStatusCapturedPendingSettlement                        = "capturedPendingSettlement"
+	TransactionStatusCommunicationError                               = "communicationError"
+	TransactionStatusRefundSettledSuccessfully                        = "refundSettledSuccessfully"
+	TransactionStatusRefundPendingSettlement                          = "refundPendingSettlement"
+	TransactionStatusApprovedReview                                   = "approvedReview"
+	TransactionStatusDeclined                                         = "declined"
+	TransactionStatusCouldNotVoid                                     = "couldNotVoid"
+	TransactionStatusExpired                                          = "expired"
+	TransactionStatusGeneralError                                     = "generalError"
+	TransactionStatusPendingFinalSettlement                           = "pendingFinalSettlement"
+	TransactionStatusPendingSettlement                                = "pendingSettlement"
+	TransactionStatusFailedReview                                     = "failedReview"
+	TransactionStatusSettledSuccessfully                              = "settledSuccessfully"
+	TransactionStatusSettlementError                                  = "settlementError"
+	TransactionStatusUnderReview                                      = "underReview"
+	TransactionStatusUpdatingSettlement                               = "updatingSettlement"
+	TransactionStatusVoided                                           = "voided"
+	TransactionStatusFDSPendingReview                                 = "FDSPendingReview"
+	TransactionStatusFDSAuthorizedPendingReview                       = "FDSAuthorizedPendingReview"
+	TransactionStatusReturnedItem                                     = "returnedItem"
+	TransactionStatusChargeback                                       = "chargeback"
+	TransactionStatusChargebackReversal                               = "chargebackReversal"
+	TransactionStatusAuthorizedPendingRelease                         = "authorizedPendingRelease"
+)
+
+type SettlementStateEnum = string
+
+const (
+	SettlementStateSettledSuccessfully SettlementStateEnum = "settledSuccessfully"
+	SettlementStateSettlementError                         = "settlementError"
+	SettlementStatePendingSettlement                       = "pendingSettlement"
+)
+
+type TransactionGroupStatusEnum = string
+
+const (
+	TransactionGroupStatusAny             TransactionGroupStatusEnum = "any"
+	TransactionGroupStatusPendingApproval                            = "pendingApproval"
+)
+
+type TransactionListOrderFieldEnum = string
+
+const (
+	TransactionListOrderFieldId            TransactionListOrderFieldEnum = "id"
+	TransactionListOrderFieldSubmitTimeUTC                               = "submitTimeUTC"
+)
+
+type TransactionListSorting struct {
+	OrderBy         TransactionListOrderFieldEnum `xml:"orderBy" validation:"required,oneOf=id submitTimeUTC"`
+	OrderDescending bool                          `xml:"orderDescending"`
+}
+
+// BatchStatisticType contains the totals of a settlement batch for a single account type.
+type BatchStatisticType struct {
+	AccountType               string  `xml:"accountType"`
+	ChargeAmount              Amount  `xml:"chargeAmount"`
+	ChargeCount               int     `xml:"chargeCount"`
+	RefundAmount              Amount  `xml:"refundAmount"`
+	RefundCount               int     `xml:"refundCount"`
+	VoidCount                 int     `xml:"voidCount"`
+	DeclineCount              int     `xml:"declineCount"`
+	ErrorCount                int     `xml:"errorCount"`
+	ReturnedItemAmount        *Amount `xml:"returnedItemAmount,omitempty"`
+	ReturnedItemCount         *int    `xml:"returnedItemCount,omitempty"`
+	ChargebackAmount          *Amount `xml:"chargebackAmount,omitempty"`
+	ChargebackCount           *int    `xml:"chargebackCount,omitempty"`
+	CorrectionNoticeCount     *int    `xml:"correctionNoticeCount,omitempty"`
+	ChargeChargeBackAmount    *Amount `xml:"chargeChargeBackAmount,omitempty"`
+	ChargeChargeBackCount     *int    `xml:"chargeChargeBackCount,omitempty"`
+	RefundChargeBackAmount    *Amount `xml:"refundChargeBackAmount,omitempty"`
+	RefundChargeBackCount     *int    `xml:"refundChargeBackCount,omitempty"`
+	ChargeReturnedItemsAmount *Amount `xml:"chargeReturnedItemsAmount,omitempty"`
+	ChargeReturnedItemsCount  *int    `xml:"chargeReturnedItemsCount,omitempty"`
+	RefundReturnedItemsAmount *Amount `xml:"refundReturnedItemsAmount,omitempty"`
+	RefundReturnedItemsCount  *int    `xml:"refundReturnedItemsCount,omitempty"`
+}
+
+type ArrayOfBatchStatisticType struct {
+	Statistic []BatchStatisticType `xml:"statistic,omitempty"`
+}
+
+// BatchDetailsType describes a settlement batch. Statistics are only returned when requested.
+type BatchDetailsType struct {
+	BatchId             string                     `xml:"batchId"`
+	SettlementTimeUTC   *DateTime                  `xml:"settlementTimeUTC,omitempty"`
+	SettlementTimeLocal *DateTime                  `xml:"settlementTimeLocal,omitempty"`
+	SettlementState     SettlementStateEnum        `xml:"settlementState"`
+	PaymentMethod       string                     `xml:"paymentMethod,omitempty"`
+	MarketType          string                     `xml:"marketType,omitempty"`
+	Product             string                     `xml:"product,omitempty"`
+	Statistics          *ArrayOfBatchStatisticType `xml:"statistics,omitempty"`
+}
+
+type ArrayOfBatchDetailsType struct {
+	Batch []BatchDetailsType `xml:"batch,omitempty"`
+}
+
+type SubscriptionPaymentType struct {
+	Id     string `xml:"id"`
+	PayNum int    `xml:"payNum"`
+}
+
+type ArrayOfFraudFilterType struct {
+	FraudFilter []string `xml:"fraudFilter,omitempty"`
+}
+
+type FraudInformationType struct {
+	FraudFilterList ArrayOfFraudFilterType `xml:"fraudFilterList"`
+	FraudAction     string                 `xml:"fraudAction"`
+}
+
+// TransactionSummaryType is the summary of a transaction returned by the transaction list requests.
+type TransactionSummaryType struct {
+	TransId           string                   `xml:"transId"`
+	SubmitTimeUTC     *DateTime                `xml:"submitTimeUTC,omitempty"`
+	SubmitTimeLocal   *DateTime                `xml:"submitTimeLocal,omitempty"`
+	TransactionStatus TransactionStatusEnum    `xml:"transactionStatus"`
+	InvoiceNumber     string                   `xml:"invoiceNumber,omitempty"`
+	FirstName         string                   `xml:"firstName,omitempty"`
+	LastName          string                   `xml:"lastName,omitempty"`
+	AccountType       string                   `xml:"accountType"`
+	AccountNumber     string                   `xml:"accountNumber"`
+	SettleAmount      Amount                   `xml:"settleAmount"`
+	MarketType        string                   `xml:"marketType,omitempty"`
+	Product           string                   `xml:"product,omitempty"`
+	MobileDeviceId    string                   `xml:"mobileDeviceId,omitempty"`
+	Subscription      *SubscriptionPaymentType `xml:"subscription,omitempty"`
+	HasReturnedItems  *bool                    `xml:"hasReturnedItems,omitempty"`
+	FraudInformation  *FraudInformationType    `xml:"fraudInformation,omitempty"`
+	Profile           *CustomerProfileIdType   `xml:"profile,omitempty"`
+}
+
+type ArrayOfTransactionSummaryType struct {
+	Transaction []TransactionSummaryType `xml:"transaction,omitempty"`
+}
+
+type FDSFilterType struct {
+	Name   string `xml:"name"`
+	Action string `xml:"action"`
+}
+
+type ArrayOfFDSFilter struct {
+	FDSFilter []FDSFilterType `xml:"FDSFilter,omitempty"`
+}
+
+type ReturnedItemType struct {
+	Id          string    `xml:"id"`
+	DateUTC     *DateTime `xml:"dateUTC,omitempty"`
+	DateLocal   *DateTime `xml:"dateLocal,omitempty"`
+	Code        string    `xml:"code"`
+	Description string    `xml:"description"`
+}
+
+type ArrayOfReturnedItem struct {
+	ReturnedItem []ReturnedItemType `xml:"returnedItem,omitempty"`
+}
+
+// OrderExType is the order information returned with transaction details.
+type OrderExType struct {
+	OrderType
+	PurchaseOrderNumber string `xml:"purchaseOrderNumber,omitempty" validation:"max=25"`
+}
+
+// TransactionDetailsType contains the full details of a single transaction.
+type TransactionDetailsType struct {
+	TransId                   string                      `xml:"transId"`
+	RefTransId                string                      `xml:"refTransId,omitempty"`
+	SplitTenderId             string                      `xml:"splitTenderId,omitempty"`
+	SubmitTimeUTC             *DateTime                   `xml:"submitTimeUTC,omitempty"`
+	SubmitTimeLocal           *DateTime                   `xml:"submitTimeLocal,omitempty"`
+	TransactionType           TransactionTypeEnum         `xml:"transactionType"`
+	TransactionStatus         TransactionStatusEnum       `xml:"transactionStatus"`
+	ResponseCode              int                         `xml:"responseCode"`
+	ResponseReasonCode        int                         `xml:"responseReasonCode"`
+	Subscription              *SubscriptionPaymentType    `xml:"subscription,omitempty"`
+	ResponseReasonDescription string                      `xml:"responseReasonDescription"`
+	AuthCode                  string                      `xml:"authCode,omitempty"`
+	AVSResponse               string                      `xml:"AVSResponse,omitempty"`
+	CardCodeResponse          string                      `xml:"cardCodeResponse,omitempty"`
+	CAVVResponse              string                      `xml:"CAVVResponse,omitempty"`
+	FDSFilterAction           string                      `xml:"FDSFilterAction,omitempty"`
+	FDSFilters                *ArrayOfFDSFilter           `xml:"FDSFilters,omitempty"`
+	Batch                     *BatchDetailsType           `xml:"batch,omitempty"`
+	Order                     *OrderExType                `xml:"order,omitempty"`
+	RequestedAmount           *Amount                     `xml:"requestedAmount,omitempty"`
+	AuthAmount                Amount                      `xml:"authAmount"`
+	SettleAmount              Amount                      `xml:"settleAmount"`
+	Tax                       *ExtendedAmountType         `xml:"tax,omitempty"`
+	Shipping                  *ExtendedAmountType         `xml:"shipping,omitempty"`
+	Duty                      *ExtendedAmountType         `xml:"duty,omitempty"`
+	LineItems                 *ArrayOfLineItem            `xml:"lineItems,omitempty"`
+	PrepaidBalanceRemaining   *Amount                     `xml:"prepaidBalanceRemaining,omitempty"`
+	TaxExempt                 *bool                       `xml:"taxExempt,omitempty"`
+	Payment                   *PaymentMaskedType          `xml:"payment,omitempty"`
+	Customer                  *CustomerDataType           `xml:"customer,omitempty"`
+	BillTo                    *CustomerAddressType        `xml:"billTo,omitempty"`
+	ShipTo                    *NameAndAddressType         `xml:"shipTo,omitempty"`
+	RecurringBilling          *bool                       `xml:"recurringBilling,omitempty"`
+	CustomerIP                string                      `xml:"customerIP,omitempty"`
+	Product                   string                      `xml:"product,omitempty"`
+	EntryMode                 string                      `xml:"entryMode,omitempty"`
+	MarketType                string                      `xml:"marketType,omitempty"`
+	MobileDeviceId            string                      `xml:"mobileDeviceId,omitempty"`
+	CustomerSignature         string                      `xml:"customerSignature,omitempty"`
+	ReturnedItems             *ArrayOfReturnedItem        `xml:"returnedItems,omitempty"`
+	Solution                  *SolutionType               `xml:"solution,omitempty"`
+	Profile                   *CustomerProfileIdType      `xml:"profile,omitempty"`
+	Surcharge                 *ExtendedAmountType         `xml:"surcharge,omitempty"`
+	EmployeeId                string                      `xml:"employeeId,omitempty"`
+	Tip                       *ExtendedAmountType         `xml:"tip,omitempty"`
+	OtherTax                  *OtherTaxType               `xml:"otherTax,omitempty"`
+	ShipFrom                  *NameAndAddressType         `xml:"shipFrom,omitempty"`
+	NetworkTransId            string                      `xml:"networkTransId,omitempty"`
+	OriginalNetworkTransId    string                      `xml:"originalNetworkTransId,omitempty"`
+	OriginalAuthAmount        *Amount                     `xml:"originalAuthAmount,omitempty"`
+	AuthorizationIndicator    *AuthorizationIndicatorType `xml:"authorizationIndicator,omitempty"`
+}
+
+// GetSettledBatchListRequest retrieves the settled batches within a date range of at most 31 days. When no dates are
+// set the batches of the last 24 hours are returned.
+type GetSettledBatchListRequest struct {
+	ANetApiRequest
+	XMLName             xml.Name  `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getSettledBatchListRequest"`
+	IncludeStatistics   *bool     `xml:"includeStatistics,omitempty"`
+	FirstSettlementDate *DateTime `xml:"firstSettlementDate,omitempty"`
+	LastSettlementDate  *DateTime `xml:"lastSettlementDate,omitempty"`
+}
+
+type GetSettledBatchListResponse struct {
+	ANetApiResponse
+	XMLName   xml.Name                 `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getSettledBatchListResponse"`
+	BatchList *ArrayOfBatchDetailsType `xml:"batchList,omitempty"`
+}
+
+type GetTransactionListRequest struct {
+	ANetApiRequest
+	XMLName xml.Name                `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getTransactionListRequest"`
+	BatchId string                  `xml:"batchId,omitempty" validation:"numeric"`
+	Sorting *TransactionListSorting `xml:"sorting,omitempty"`
+	Paging  *Paging                 `xml:"paging,omitempty"`
+}
+
+// GetTransactionListResponse is returned by both GetTransactionList and GetTransactionListForCustomer.
+type GetTransactionListResponse struct {
+	ANetApiResponse
+	XMLName             xml.Name                       `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getTransactionListResponse"`
+	Transactions        *ArrayOfTransactionSummaryType `xml:"transactions,omitempty"`
+	TotalNumInResultSet int                            `xml:"totalNumInResultSet"`
+}
+
+type GetUnsettledTransactionListRequest struct {
+	ANetApiRequest
+	XMLName xml.Name                   `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getUnsettledTransactionListRequest"`
+	Status  TransactionGroupStatusEnum `xml:"status,omitempty" validation:"oneOf=any pendingApproval"`
+	Sorting *TransactionListSorting    `xml:"sorting,omitempty"`
+	Paging  *Paging                    `xml:"paging,omitempty"`
+}
+
+type GetUnsettledTransactionListResponse struct {
+	ANetApiResponse
+	XMLName             xml.Name                       `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getUnsettledTransactionListResponse"`
+	Transactions        *ArrayOfTransactionSummaryType `xml:"transactions,omitempty"`
+	TotalNumInResultSet int                            `xml:"totalNumInResultSet"`
+}
+
+type GetTransactionDetailsRequest struct {
+	ANetApiRequest
+	XMLName xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getTransactionDetailsRequest"`
+	TransId string   `xml:"transId" validation:"required,numeric"`
+}
+
+type GetTransactionDetailsResponse struct {
+	ANetApiResponse
+	XMLName     xml.Name                `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getTransactionDetailsResponse"`
+	Transaction *TransactionDetailsType `xml:"transaction,omitempty"`
+	ClientId    string                  `xml:"clientId,omitempty"`
+	// TransRefId is the RefId sent with the original transaction request.
+	TransRefId string `xml:"transrefId,omitempty"`
+}
+
+type GetBatchStatisticsRequest struct {
+	ANetApiRequest
+	XMLName xml.Name `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getBatchStatisticsRequest"`
+	BatchId string   `xml:"batchId" validation:"required,numeric"`
+}
+
+type GetBatchStatisticsResponse struct {
+	ANetApiResponse
+	XMLName xml.Name          `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getBatchStatisticsResponse"`
+	Batch   *BatchDetailsType `xml:"batch,omitempty"`
+}
+
+type GetTransactionListForCustomerRequest struct {
+	ANetApiRequest
+	XMLName                  xml.Name                `xml:"AnetApi/xml/v1/schema/AnetApiSchema.xsd getTransactionListForCustomerRequest"`
+	CustomerProfileId        string                  `xml:"customerProfileId" validation:"required,numeric"`
+	CustomerPaymentProfileId string                  `xml:"customerPaymentProfileId,omitempty" validation:"numeric"`
+	Sorting                  *TransactionListSorting `xml:"sorting,omitempty"`
+	Paging                   *Paging                 `xml:"paging,omitempty"`
+}
+
+// GetSettledBatchList retrieves the settled batches within a date range.
+func (c *AuthNetClient) GetSettledBatchList(req GetSettledBatchListRequest) (*GetSettledBatchListResponse, error) {
+	return c.GetSettledBatchListContext(context.Background(), req)
+}
+
+// GetSettledBatchListContext is like GetSettledBatchList but uses ctx for the request.
+func (c *AuthNetClient) GetSettledBatchListContext(ctx context.Context, req GetSettledBatchListRequest) (*GetSettledBatchListResponse, error) {
+	var res GetSettledBatchListResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetTransactionList retrieves the transactions of a settled batch.
+func (c *AuthNetClient) GetTransactionList(req GetTransactionListRequest) (*GetTransactionListResponse, error) {
+	return c.GetTransactionListContext(context.Background(), req)
+}
+
+// GetTransactionListContext is like GetTransactionList but uses ctx for the request.
+func (c *AuthNetClient) GetTransactionListContext(ctx context.Context, req GetTransactionListRequest) (*GetTransactionListResponse, error) {
+	var res GetTransactionListResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetUnsettledTransactionList retrieves the transactions that have not been settled yet.
+func (c *AuthNetClient) GetUnsettledTransactionList(req GetUnsettledTransactionListRequest) (*GetUnsettledTransactionListResponse, error) {
+	return c.GetUnsettledTransactionListContext(context.Background(), req)
+}
+
+// GetUnsettledTransactionListContext is like GetUnsettledTransactionList but uses ctx for the request.
+func (c *AuthNetClient) GetUnsettledTransactionListContext(ctx context.Context, req GetUnsettledTransactionListRequest) (*GetUnsettledTransactionListResponse, error) {
+	var res GetUnsettledTransactionListResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetTransactionDetails retrieves the full details of a single transaction.
+func (c *AuthNetClient) GetTransactionDetails(req GetTransactionDetailsRequest) (*GetTransactionDetailsResponse, error) {
+	return c.GetTransactionDetailsContext(context.Background(), req)
+}
+
+// GetTransactionDetailsContext is like GetTransactionDetails but uses ctx for the request.
+func (c *AuthNetClient) GetTransactionDetailsContext(ctx context.Context, req GetTransactionDetailsRequest) (*GetTransactionDetailsResponse, error) {
+	var res GetTransactionDetailsResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetBatchStatistics retrieves the statistics of a single settled batch.
+func (c *AuthNetClient) GetBatchStatistics(req GetBatchStatisticsRequest) (*GetBatchStatisticsResponse, error) {
+	return c.GetBatchStatisticsContext(context.Background(), req)
+}
+
+// GetBatchStatisticsContext is like GetBatchStatistics but uses ctx for the request.
+func (c *AuthNetClient) GetBatchStatisticsContext(ctx context.Context, req GetBatchStatisticsRequest) (*GetBatchStatisticsResponse, error) {
+	var res GetBatchStatisticsResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetTransactionListForCustomer retrieves the transactions of a customer profile and optionally a single payment
+// profile.
+func (c *AuthNetClient) GetTransactionListForCustomer(req GetTransactionListForCustomerRequest) (*GetTransactionListResponse, error) {
+	return c.GetTransactionListForCustomerContext(context.Background(), req)
+}
+
+// GetTransactionListForCustomerContext is like GetTransactionListForCustomer but uses ctx for the request.
+func (c *AuthNetClient) GetTransactionListForCustomerContext(ctx context.Context, req GetTransactionListForCustomerRequest) (*GetTransactionListResponse, error) {
+	var res GetTransactionListResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,200 @@
+package authnet
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReportingOperations(t *testing.T) {
+	first := DateTime{time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)}
+	last := DateTime{time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)}
+	transaction := `<transaction><transId>60000000001</transId><submitTimeUTC>2024-05-02T10:00:00Z</submitTimeUTC>` +
+		`<submitTimeLocal>2024-05-02T04:00:00</submitTimeLocal><transactionStatus>settledSuccessfully</transactionStatus>` +
+		`<invoiceNumber>INV-1</invoiceNumber><accountType>Visa</accountType><accountNumber>XXXX1111</accountNumber>` +
+		`<settleAmount>12.50</settleAmount></transaction>`
+	checkSummary := func(t *testing.T, transactions *ArrayOfTransactionSummaryType) {
+		t.Helper()
+		if transactions == nil || len(transactions.Transaction) != 1 {
+			t.Fatalf("unexpected transactions %+v", transactions)
+		}
+		summary := transactions.Transaction[0]
+		if summary.TransId != "60000000001" || summary.InvoiceNumber != "INV-1" || summary.SettleAmount.String() != "12.50" ||
+			!summary.SubmitTimeUTC.Equal(time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC)) ||
+			!summary.SubmitTimeLocal.Equal(time.Date(2024, 5, 2, 4, 0, 0, 0, time.UTC)) {
+			t.Errorf("unexpected transaction %+v", summary)
+		}
+	}
+	runOperationCases(t, []operationCase{
+		{
+			name: "settled batch list",
+			response: `<batchList><batch><batchId>100</batchId><settlementTimeUTC>2024-05-02T12:00:00Z</settlementTimeUTC>` +
+				`<settlementState>settledSuccessfully</settlementState><paymentMethod>creditCard</paymentMethod>` +
+				`<statistics><statistic><accountType>Visa</accountType><chargeAmount>100.00</chargeAmount>` +
+				`<chargeCount>4</chargeCount><refundAmount>0.00</refundAmount><refundCount>0</refundCount>` +
+				`<voidCount>1</voidCount><declineCount>0</declineCount><errorCount>0</errorCount></statistic></statistics>` +
+				`</batch></batchList>`,
+			call: func(t *testing.T, c *AuthNetClient) {
+				includeStatistics := true
+				res, err := c.GetSettledBatchList(GetSettledBatchListRequest{
+					ANetApiRequest:      ANetApiRequest{MerchantAuthentication: testAuth},
+					IncludeStatistics:   &includeStatistics,
+					FirstSettlementDate: &first,
+					LastSettlementDate:  &last,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if res.BatchList == nil || len(res.BatchList.Batch) != 1 {
+					t.Fatalf("unexpected batch list %+v", res.BatchList)
+				}
+				batch := res.BatchList.Batch[0]
+				if batch.BatchId != "100" || batch.SettlementState != SettlementStateSettledSuccessfully ||
+					batch.Statistics == nil || batch.Statistics.Statistic[0].ChargeAmount.String() != "100.00" ||
+					batch.Statistics.Statistic[0].VoidCount != 1 {
+					t.Errorf("unexpected batch %+v", batch)
+				}
+			},
+			request: []string{
+				`<getSettledBatchListRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`<includeStatistics>true</includeStatistics><firstSettlementDate>2024-05-01T00:00:00Z</firstSettlementDate>` +
+					`<lastSettlementDate>2024-05-31T00:00:00Z</lastSettlementDate>`,
+			},
+		},
+		{
+			name:     "transaction list",
+			response: `<transactions>` + transaction + `</transactions><totalNumInResultSet>1</totalNumInResultSet>`,
+			call: func(t *testing.T, c *AuthNetClient) {
+				res, err := c.GetTransactionList(GetTransactionListRequest{
+					ANetApiRequest: ANetApiRequest{MerchantAuthentication: testAuth},
+					BatchId:        "100",
+					Sorting:        &TransactionListSorting{OrderBy: TransactionListOrderFieldSubmitTimeUTC, OrderDescending: true},
+					Paging:         &Paging{Limit: 10, Offset: 1},
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				checkSummary(t, res.Transactions)
+				if res.TotalNumInResultSet != 1 {
+					t.Errorf("unexpected total %d", res.TotalNumInResultSet)
+				}
+			},
+			request: []string{
+				`<getTransactionListRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`<batchId>100</batchId><sorting><orderBy>submitTimeUTC</orderBy><orderDescending>true</orderDescending></sorting>` +
+					`<paging><limit>10</limit><offset>1</offset></paging>`,
+			},
+		},
+		{
+			name:     "unsettled transaction list",
+			response: `<transactions>` + transaction + `</transactions><totalNumInResultSet>1</totalNumInResultSet>`,
+			call: func(t *testing.T, c *AuthNetClient) {
+				res, err := c.GetUnsettledTransactionList(GetUnsettledTransactionListRequest{
+					ANetApiRequest: ANetApiRequest{MerchantAuthentication: testAuth},
+					Status:         TransactionGroupStatusPendingApproval,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				checkSummary(t, res.Transactions)
+			},
+			request: []string{
+				`<getUnsettledTransactionListRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`<status>pendingApproval</status></getUnsettledTransactionListRequest>`,
+			},
+		},
+		{
+			name: "transaction details",
+			response: `<transaction><transId>60000000001</transId><transactionType>authCaptureTransaction</transactionType>` +
+				`<transactionStatus>settledSuccessfully</transactionStatus><responseCode>1</responseCode>` +
+				`<responseReasonCode>1</responseReasonCode><responseReasonDescription>Approval</responseReasonDescription>` +
+				`<authCode>ABC123</authCode><batch><batchId>100</batchId><settlementState>settledSuccessfully</settlementState></batch>` +
+				`<order><invoiceNumber>INV-1</invoiceNumber><purchaseOrderNumber>PO-1</purchaseOrderNumber></order>` +
+				`<authAmount>12.50</authAmount><settleAmount>12.50</settleAmount>` +
+				`<payment><creditCard><cardNumber>XXXX1111</cardNumber><expirationDate>XXXX</expirationDate></creditCard></payment>` +
+				`</transaction><clientId>client</clientId><transrefId>ref-1</transrefId>`,
+			call: func(t *testing.T, c *AuthNetClient) {
+				res, err := c.GetTransactionDetails(GetTransactionDetailsRequest{
+					ANetApiRequest: ANetApiRequest{MerchantAuthentication: testAuth},
+					TransId:        "60000000001",
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				details := res.Transaction
+				if details == nil || details.TransactionType != TransactionTypeAuthCaptureTransaction ||
+					details.ResponseReasonDescription != "Approval" || details.Batch.BatchId != "100" ||
+					details.Order.PurchaseOrderNumber != "PO-1" || details.SettleAmount.String() != "12.50" ||
+					details.Payment.CreditCard.CardNumber != "XXXX1111" {
+					t.Errorf("unexpected details %+v", details)
+				}
+				if res.TransRefId != "ref-1" || res.ClientId != "client" {
+					t.Errorf("unexpected response %+v", res)
+				}
+			},
+			request: []string{
+				`<getTransactionDetailsRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`<transId>60000000001</transId></getTransactionDetailsRequest>`,
+			},
+		},
+		{
+			name: "batch statistics",
+			response: `<batch><batchId>100</batchId><settlementState>settledSuccessfully</settlementState>` +
+				`<statistics><statistic><accountType>Visa</accountType><chargeAmount>100.00</chargeAmount>` +
+				`<chargeCount>4</chargeCount><refundAmount>5.00</refundAmount><refundCount>1</refundCount>` +
+				`<voidCount>0</voidCount><declineCount>2</declineCount><errorCount>0</errorCount>` +
+				`<chargebackAmount>10.00</chargebackAmount><chargebackCount>1</chargebackCount></statistic></statistics></batch>`,
+			call: func(t *testing.T, c *AuthNetClient) {
+				res, err := c.GetBatchStatistics(GetBatchStatisticsRequest{
+					ANetApiRequest: ANetApiRequest{MerchantAuthentication: testAuth},
+					BatchId:        "100",
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if res.Batch == nil || res.Batch.Statistics == nil {
+					t.Fatalf("unexpected batch %+v", res.Batch)
+				}
+				statistic := res.Batch.Statistics.Statistic[0]
+				if statistic.RefundAmount.String() != "5.00" || statistic.DeclineCount != 2 ||
+					statistic.ChargebackCount == nil || *statistic.ChargebackCount != 1 || statistic.ReturnedItemCount != nil {
+					t.Errorf("unexpected statistic %+v", statistic)
+				}
+			},
+			request: []string{
+				`<getBatchStatisticsRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+				`<batchId>100</batchId></getBatchStatisticsRequest>`,
+			},
+		},
+	})
+}
+
+func TestGetTransactionListForCustomer(t *testing.T) {
+	// The gateway answers with the same response as getTransactionListRequest.
+	client, recorded := newRecordingClientNamed(t, "getTransactionListResponse",
+		`<transactions><transaction><transId>60000000001</transId><transactionStatus>voided</transactionStatus>`+
+			`<accountType>eCheck</accountType><accountNumber>XXXX6789</accountNumber><settleAmount>0.00</settleAmount>`+
+			`<profile><customerProfileId>10</customerProfileId><customerPaymentProfileId>20</customerPaymentProfileId></profile>`+
+			`</transaction></transactions><totalNumInResultSet>1</totalNumInResultSet>`)
+	res, err := client.GetTransactionListForCustomer(GetTransactionListForCustomerRequest{
+		ANetApiRequest:           ANetApiRequest{MerchantAuthentication: testAuth},
+		CustomerProfileId:        "10",
+		CustomerPaymentProfileId: "20",
+		Paging:                   &Paging{Limit: 100, Offset: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Transactions == nil || len(res.Transactions.Transaction) != 1 || res.TotalNumInResultSet != 1 {
+		t.Fatalf("unexpected response %+v", res)
+	}
+	summary := res.Transactions.Transaction[0]
+	if summary.TransactionStatus != TransactionStatusVoided || summary.Profile == nil ||
+		summary.Profile.CustomerPaymentProfileId != "20" {
+		t.Errorf("unexpected transaction %+v", summary)
+	}
+	assertFragments(t, recorded.String(),
+		`<getTransactionListForCustomerRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>`,
+		`<customerProfileId>10</customerProfileId><customerPaymentProfileId>20</customerPaymentProfileId>`+
+			`<paging><limit>100</limit><offset>2</offset></paging>`,
+	)
+}
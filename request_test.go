@@ -1,8 +1,14 @@
 package authnet
 
+import "os"
+
+// ac is the client for tests against the sandbox, nil when GOGO_AUTHNET_CONFIG is not set.
 var ac *AuthNetClient
 
 func init() {
+	if _, found := os.LookupEnv(EnvGoGoAuthnetConfig); !found {
+		return
+	}
 	conf, loadErr := LoadConfigFromEnv()
 	if loadErr != nil {
 		panic(loadErr)
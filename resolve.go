@@ -0,0 +1,247 @@
+package authnet
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+type ResolutionStatusEnum = string
+
+const (
+	// ResolutionCharged a matching transaction was approved and holds or has taken the funds.
+	ResolutionCharged ResolutionStatusEnum = "charged"
+	// ResolutionNotCharged the window has passed and no matching transaction was found, or every match was declined,
+	// errored or voided.
+	ResolutionNotCharged = "notCharged"
+	// ResolutionUnknown the outcome could not be determined, see the returned error.
+	ResolutionUnknown = "unknown"
+)
+
+// Resolution is the outcome of ResolveTransaction.
+type Resolution struct {
+	Status ResolutionStatusEnum
+	// TransId is the matching transaction that charged the customer or, when not charged, the most recent matching
+	// transaction if there was one.
+	TransId string
+	// Matches holds the details of every transaction matching the request, most recent first.
+	Matches []TransactionDetailsType
+}
+
+// ErrResolutionPending is returned with ResolutionUnknown when no charge was found but the window has not passed yet, so
+// a matching transaction could still be listed.
+var ErrResolutionPending = errors.New("transaction window has not passed yet")
+
+// resolutionMargin is how long after the window a missing transaction is taken as not charged. It allows for clock skew
+// between this host and the gateway and for the transaction lists to catch up.
+const resolutionMargin = time.Minute
+
+// maxSettledBatchListRange is the longest date range the gateway accepts for a settled batch list.
+const maxSettledBatchListRange = 31 * 24 * time.Hour
+
+// notChargedStatuses are the transaction statuses in which the customer has not been charged.
+var notChargedStatuses = map[TransactionStatusEnum]bool{
+	TransactionStatusDeclined:           true,
+	TransactionStatusGeneralError:       true,
+	TransactionStatusCommunicationError: true,
+	TransactionStatusFailedReview:       true,
+	TransactionStatusExpired:            true,
+	TransactionStatusVoided:             true,
+	TransactionStatusSettlementError:    true,
+}
+
+// ResolveTransaction determines whether a transaction request whose response was lost, for example because the
+// connection failed after the request was sent, charged the customer.
+//
+// The unsettled transactions, and the transactions of the batches settled since, submitted within window of
+// submittedAt are searched for ones matching the invoice number, refId, amount, transaction type and customer id of
+// req. The settled batches are listed in ranges of at most 31 days, the longest the gateway accepts. The transaction
+// lists are narrowed by invoice number and amount before the details of each remaining transaction are requested. req
+// must have an invoice number or a refId, and the Transaction Details API must be enabled for the merchant.
+//
+// The customer is only reported as not charged once submittedAt+window is a minute in the past. Until then a search
+// without a charge returns ResolutionUnknown with ErrResolutionPending.
+func (c *AuthNetClient) ResolveTransaction(req CreateTransactionRequestType, submittedAt time.Time, window time.Duration) (*Resolution, error) {
+	return c.ResolveTransactionContext(context.Background(), req, submittedAt, window)
+}
+
+// ResolveTransactionContext is like ResolveTransaction but uses ctx for every request.
+func (c *AuthNetClient) ResolveTransactionContext(ctx context.Context, req CreateTransactionRequestType, submittedAt time.Time, window time.Duration) (*Resolution, error) {
+	t := req.TransactionRequestType
+	invoiceNumber := ""
+	if t.Order != nil {
+		invoiceNumber = t.Order.InvoiceNumber
+	}
+	if len(invoiceNumber) == 0 && len(req.RefId) == 0 {
+		return &Resolution{Status: ResolutionUnknown}, errors.New("transaction request requires an invoice number or a refId to be resolved")
+	}
+	from, to := submittedAt.Add(-window), submittedAt.Add(window)
+	auth := req.MerchantAuthentication
+
+	var candidates []string
+	seen := map[string]bool{}
+	collect := func(it *TransactionIterator) error {
+		defer it.Close()
+		for it.Next() {
+			summary := it.Item()
+			if summary.SubmitTimeUTC != nil {
+				if summary.SubmitTimeUTC.Before(from) {
+					break
+				}
+				if summary.SubmitTimeUTC.After(to) {
+					continue
+				}
+			}
+			if len(invoiceNumber) > 0 && summary.InvoiceNumber != invoiceNumber || !summaryMatchesAmount(t, summary) {
+				continue
+			}
+			if !seen[summary.TransId] {
+				seen[summary.TransId] = true
+				candidates = append(candidates, summary.TransId)
+			}
+		}
+		return it.Err()
+	}
+
+	newestFirst := &TransactionListSorting{OrderBy: TransactionListOrderFieldSubmitTimeUTC, OrderDescending: true}
+	if err := collect(c.UnsettledTransactionListIteratorContext(ctx, GetUnsettledTransactionListRequest{
+		ANetApiRequest: ANetApiRequest{MerchantAuthentication: auth},
+		Status:         TransactionGroupStatusAny,
+		Sorting:        newestFirst,
+	}, 0)); err != nil {
+		return &Resolution{Status: ResolutionUnknown}, err
+	}
+
+	batchIds, batchErr := c.settledBatchIds(ctx, auth, from, time.Now())
+	if batchErr != nil {
+		return &Resolution{Status: ResolutionUnknown}, batchErr
+	}
+	for _, batchId := range batchIds {
+		if err := collect(c.TransactionListIteratorContext(ctx, GetTransactionListRequest{
+			ANetApiRequest: ANetApiRequest{MerchantAuthentication: auth},
+			BatchId:        batchId,
+			Sorting:        newestFirst,
+		}, 0)); err != nil {
+			return &Resolution{Status: ResolutionUnknown}, err
+		}
+	}
+
+	resolution := Resolution{Status: ResolutionNotCharged}
+	for _, transId := range candidates {
+		details, detailsErr := c.GetTransactionDetailsContext(ctx, GetTransactionDetailsRequest{
+			ANetApiRequest: ANetApiRequest{MerchantAuthentication: auth},
+			TransId:        transId,
+		})
+		if detailsErr != nil {
+			return &Resolution{Status: ResolutionUnknown, Matches: resolution.Matches}, detailsErr
+		}
+		if details.Transaction == nil || !matchesTransactionRequest(req, details) {
+			continue
+		}
+		resolution.Matches = append(resolution.Matches, *details.Transaction)
+		if len(resolution.TransId) == 0 {
+			resolution.TransId = transId
+		}
+		if resolution.Status != ResolutionCharged && !notChargedStatuses[details.Transaction.TransactionStatus] {
+			resolution.Status = ResolutionCharged
+			resolution.TransId = transId
+		}
+	}
+	if resolution.Status != ResolutionCharged && time.Now().Before(to.Add(resolutionMargin)) {
+		resolution.Status = ResolutionUnknown
+		return &resolution, ErrResolutionPending
+	}
+	return &resolution, nil
+}
+
+// settledBatchIds lists the batches settled between from and to, requesting the list in ranges the gateway accepts.
+func (c *AuthNetClient) settledBatchIds(ctx context.Context, auth MerchantAuthenticationType, from time.Time, to time.Time) ([]string, error) {
+	var batchIds []string
+	seen := map[string]bool{}
+	first, last := from.UTC().Truncate(time.Second), to.UTC().Truncate(time.Second)
+	for {
+		end := first.Add(maxSettledBatchListRange)
+		if end.After(last) {
+			end = last
+		}
+		batches, err := c.GetSettledBatchListContext(ctx, GetSettledBatchListRequest{
+			ANetApiRequest:      ANetApiRequest{MerchantAuthentication: auth},
+			FirstSettlementDate: &DateTime{Time: first},
+			LastSettlementDate:  &DateTime{Time: end},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if batches.BatchList != nil {
+			for _, batch := range batches.BatchList.Batch {
+				if !seen[batch.BatchId] {
+					seen[batch.BatchId] = true
+					batchIds = append(batchIds, batch.BatchId)
+				}
+			}
+		}
+		if !end.Before(last) {
+			return batchIds, nil
+		}
+		first = end
+	}
+}
+
+// summaryMatchesAmount reports whether the transaction of the list could be for the amount of the request. The settle
+// amount of a partial authorization is lower than requested, and it is 0.00 for some declined and voided transactions.
+func summaryMatchesAmount(t TransactionRequestType, summary TransactionSummaryType) bool {
+	if t.Amount == nil || summary.SettleAmount.IsZero() {
+		return true
+	}
+	switch summary.SettleAmount.Cmp(*t.Amount) {
+	case 0:
+		return true
+	case -1:
+		return allowsPartialAuth(t)
+	}
+	return false
+}
+
+func allowsPartialAuth(t TransactionRequestType) bool {
+	if t.TransactionSettings == nil {
+		return false
+	}
+	for _, setting := range t.TransactionSettings.Setting {
+		if setting.SettingName == "allowPartialAuth" && strings.EqualFold(strings.TrimSpace(setting.SettingValue), "true") {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesTransactionRequest compares the identifying fields of the request with the transaction details.
+func matchesTransactionRequest(req CreateTransactionRequestType, details *GetTransactionDetailsResponse) bool {
+	t := req.TransactionRequestType
+	transaction := details.Transaction
+	if len(req.RefId) > 0 && details.TransRefId != req.RefId {
+		return false
+	}
+	if t.Order != nil && len(t.Order.InvoiceNumber) > 0 &&
+		(transaction.Order == nil || transaction.Order.InvoiceNumber != t.Order.InvoiceNumber) {
+		return false
+	}
+	if len(t.TransactionType) > 0 && len(transaction.TransactionType) > 0 &&
+		!strings.EqualFold(transaction.TransactionType, t.TransactionType) {
+		return false
+	}
+	if t.Amount != nil {
+		requested := transaction.AuthAmount
+		if transaction.RequestedAmount != nil {
+			requested = *transaction.RequestedAmount
+		}
+		if requested.Cmp(*t.Amount) != 0 {
+			return false
+		}
+	}
+	if t.Customer != nil && len(t.Customer.Id) > 0 &&
+		(transaction.Customer == nil || transaction.Customer.Id != t.Customer.Id) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,332 @@
+package authnet
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const anetNamespace = "AnetApi/xml/v1/schema/AnetApiSchema.xsd"
+
+// fakeReporting serves the Transaction Reporting API from fixed transactions.
+type fakeReporting struct {
+	unsettled []string
+	batches   map[string][]string
+	details   map[string]string
+
+	mu               sync.Mutex
+	detailRequests   []string
+	settlementRanges [][2]string
+}
+
+var settlementDatePattern = regexp.MustCompile(`<firstSettlementDate>([^<]*)</firstSettlementDate><lastSettlementDate>([^<]*)<`)
+
+func summaryXML(transId string, submitTime time.Time, status string, invoiceNumber string, amount string) string {
+	return fmt.Sprintf(`<transaction><transId>%s</transId><submitTimeUTC>%s</submitTimeUTC><transactionStatus>%s</transactionStatus>`+
+		`<invoiceNumber>%s</invoiceNumber><accountType>Visa</accountType><accountNumber>XXXX1111</accountNumber>`+
+		`<settleAmount>%s</settleAmount></transaction>`, transId, submitTime.UTC().Format(time.RFC3339), status, invoiceNumber, amount)
+}
+
+func detailsXML(transId string, refId string, status string, invoiceNumber string, amount string) string {
+	return fmt.Sprintf(`<transaction><transId>%s</transId><transactionType>authCaptureTransaction</transactionType>`+
+		`<transactionStatus>%s</transactionStatus><responseCode>1</responseCode><order><invoiceNumber>%s</invoiceNumber></order>`+
+		`<authAmount>%s</authAmount><settleAmount>%s</settleAmount></transaction><transrefId>%s</transrefId>`,
+		transId, status, invoiceNumber, amount, amount, refId)
+}
+
+func (f *fakeReporting) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	var root struct {
+		XMLName xml.Name
+		BatchId string `xml:"batchId"`
+		TransId string `xml:"transId"`
+	}
+	if err := xml.Unmarshal(body, &root); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ok := okMessages
+	var inner string
+	switch root.XMLName.Local {
+	case "getUnsettledTransactionListRequest":
+		inner = ok + "<transactions>" + strings.Join(f.unsettled, "") + "</transactions>"
+	case "getSettledBatchListRequest":
+		f.mu.Lock()
+		if dates := settlementDatePattern.FindStringSubmatch(string(body)); dates != nil {
+			f.settlementRanges = append(f.settlementRanges, [2]string{dates[1], dates[2]})
+		}
+		f.mu.Unlock()
+		inner = ok + "<batchList>"
+		for batchId := range f.batches {
+			inner += "<batch><batchId>" + batchId + "</batchId><settlementState>settledSuccessfully</settlementState></batch>"
+		}
+		inner += "</batchList>"
+	case "getTransactionListRequest":
+		inner = ok + "<transactions>" + strings.Join(f.batches[root.BatchId], "") + "</transactions>"
+	case "getTransactionDetailsRequest":
+		f.mu.Lock()
+		f.detailRequests = append(f.detailRequests, root.TransId)
+		f.mu.Unlock()
+		details, found := f.details[root.TransId]
+		if !found {
+			inner = `<messages><resultCode>Error</resultCode><message><code>E00040</code><text>The record cannot be found.</text></message></messages>`
+		} else {
+			inner = ok + details
+		}
+	default:
+		http.Error(w, "unexpected request "+root.XMLName.Local, http.StatusBadRequest)
+		return
+	}
+	name := strings.TrimSuffix(root.XMLName.Local, "Request") + "Response"
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="utf-8"?><%s xmlns="%s">%s</%s>`, name, anetNamespace, inner, name)
+}
+
+func resolveRequest(refId string, invoiceNumber string, amount string) CreateTransactionRequestType {
+	req := CreateTransactionRequestType{
+		ANetApiRequest: ANetApiRequest{RefId: refId},
+		TransactionRequestType: TransactionRequestType{
+			TransactionType: TransactionTypeAuthCaptureTransaction,
+			Amount:          amountPointer(amount),
+		},
+	}
+	if len(invoiceNumber) > 0 {
+		req.TransactionRequestType.Order = &OrderType{InvoiceNumber: invoiceNumber}
+	}
+	return req
+}
+
+func amountPointer(s string) *Amount {
+	amount := MustParseAmount(s)
+	return &amount
+}
+
+func TestResolveTransaction(t *testing.T) {
+	submittedAt := time.Now().Add(-time.Hour)
+	before, after := submittedAt.Add(-time.Minute), submittedAt.Add(time.Minute)
+	outside := submittedAt.Add(-time.Hour)
+
+	cases := []struct {
+		name           string
+		req            CreateTransactionRequestType
+		gateway        *fakeReporting
+		status         ResolutionStatusEnum
+		transId        string
+		matches        int
+		detailRequests []string
+	}{
+		{
+			name: "charged",
+			req:  resolveRequest("", "INV-1", "19.99"),
+			gateway: &fakeReporting{
+				unsettled: []string{
+					summaryXML("4", after, TransactionStatusDeclined, "INV-1", "19.99"),
+					summaryXML("3", after, TransactionStatusCapturedPendingSettlement, "INV-2", "19.99"),
+				},
+				batches: map[string][]string{"b1": {
+					summaryXML("2", before, TransactionStatusSettledSuccessfully, "INV-1", "19.99"),
+					summaryXML("1", outside, TransactionStatusSettledSuccessfully, "INV-1", "19.99"),
+				}},
+				details: map[string]string{
+					"4": detailsXML("4", "", TransactionStatusDeclined, "INV-1", "19.99"),
+					"2": detailsXML("2", "", TransactionStatusSettledSuccessfully, "INV-1", "19.99"),
+				},
+			},
+			status:         ResolutionCharged,
+			transId:        "2",
+			matches:        2,
+			detailRequests: []string{"4", "2"},
+		},
+		{
+			name: "refId only narrows by amount",
+			req:  resolveRequest("ref-1", "", "19.99"),
+			gateway: &fakeReporting{
+				unsettled: []string{
+					summaryXML("7", after, TransactionStatusCapturedPendingSettlement, "", "5.00"),
+					summaryXML("6", after, TransactionStatusCapturedPendingSettlement, "", "19.99"),
+					summaryXML("5", before, TransactionStatusCapturedPendingSettlement, "", "25.00"),
+				},
+				details: map[string]string{
+					"6": detailsXML("6", "ref-1", TransactionStatusCapturedPendingSettlement, "", "19.99"),
+				},
+			},
+			status:         ResolutionCharged,
+			transId:        "6",
+			matches:        1,
+			detailRequests: []string{"6"},
+		},
+		{
+			name: "declined only",
+			req:  resolveRequest("", "INV-1", "19.99"),
+			gateway: &fakeReporting{
+				unsettled: []string{
+					summaryXML("9", after, TransactionStatusDeclined, "INV-1", "19.99"),
+					summaryXML("8", before, TransactionStatusGeneralError, "INV-1", "0.00"),
+				},
+				details: map[string]string{
+					"9": detailsXML("9", "", TransactionStatusDeclined, "INV-1", "19.99"),
+					"8": detailsXML("8", "", TransactionStatusGeneralError, "INV-1", "19.99"),
+				},
+			},
+			status:         ResolutionNotCharged,
+			transId:        "9",
+			matches:        2,
+			detailRequests: []string{"9", "8"},
+		},
+		{
+			name: "voided",
+			req:  resolveRequest("", "INV-1", "19.99"),
+			gateway: &fakeReporting{
+				unsettled: []string{summaryXML("10", after, TransactionStatusVoided, "INV-1", "19.99")},
+				batches:   map[string][]string{"b1": {summaryXML("11", before, TransactionStatusSettledSuccessfully, "INV-3", "19.99")}},
+				details:   map[string]string{"10": detailsXML("10", "", TransactionStatusVoided, "INV-1", "19.99")},
+			},
+			status:         ResolutionNotCharged,
+			transId:        "10",
+			matches:        1,
+			detailRequests: []string{"10"},
+		},
+		{
+			name: "no match",
+			req:  resolveRequest("ref-1", "INV-1", "19.99"),
+			gateway: &fakeReporting{
+				unsettled: []string{
+					summaryXML("13", after, TransactionStatusCapturedPendingSettlement, "INV-2", "19.99"),
+					summaryXML("12", before, TransactionStatusCapturedPendingSettlement, "INV-1", "20.00"),
+				},
+				batches: map[string][]string{"b1": {summaryXML("14", outside, TransactionStatusSettledSuccessfully, "INV-1", "19.99")}},
+			},
+			status: ResolutionNotCharged,
+		},
+		{
+			name: "refId mismatch",
+			req:  resolveRequest("ref-1", "INV-1", "19.99"),
+			gateway: &fakeReporting{
+				unsettled: []string{summaryXML("15", after, TransactionStatusCapturedPendingSettlement, "INV-1", "19.99")},
+				details:   map[string]string{"15": detailsXML("15", "ref-2", TransactionStatusCapturedPendingSettlement, "INV-1", "19.99")},
+			},
+			status:         ResolutionNotCharged,
+			detailRequests: []string{"15"},
+		},
+	}
+	for _, c := range cases {
+		client := newFakeClient(t, c.gateway.ServeHTTP)
+		resolution, err := client.ResolveTransaction(c.req, submittedAt, 10*time.Minute)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", c.name, err)
+			continue
+		}
+		if resolution.Status != c.status || resolution.TransId != c.transId || len(resolution.Matches) != c.matches {
+			t.Errorf("%s: expected %s %q with %d matches, got %s %q with %d", c.name, c.status, c.transId, c.matches,
+				resolution.Status, resolution.TransId, len(resolution.Matches))
+		}
+		if strings.Join(c.gateway.detailRequests, ",") != strings.Join(c.detailRequests, ",") {
+			t.Errorf("%s: expected details of %v, requested %v", c.name, c.detailRequests, c.gateway.detailRequests)
+		}
+		if len(c.gateway.settlementRanges) != 1 {
+			t.Errorf("%s: expected a single settled batch list request, got %v", c.name, c.gateway.settlementRanges)
+			continue
+		}
+		for _, date := range c.gateway.settlementRanges[0] {
+			if !regexp.MustCompile(`^\d{4}-\d\d-\d\dT\d\d:\d\d:\d\dZ$`).MatchString(date) {
+				t.Errorf("%s: expected the settlement dates in whole seconds, got %q", c.name, date)
+			}
+		}
+	}
+}
+
+func TestResolveTransactionPending(t *testing.T) {
+	submittedAt := time.Now()
+	gateway := &fakeReporting{
+		unsettled: []string{summaryXML("1", submittedAt, TransactionStatusDeclined, "INV-1", "19.99")},
+		details:   map[string]string{"1": detailsXML("1", "", TransactionStatusDeclined, "INV-1", "19.99")},
+	}
+	client := newFakeClient(t, gateway.ServeHTTP)
+	resolution, err := client.ResolveTransaction(resolveRequest("", "INV-1", "19.99"), submittedAt, 10*time.Minute)
+	if !errors.Is(err, ErrResolutionPending) || resolution.Status != ResolutionUnknown || len(resolution.Matches) != 1 {
+		t.Errorf("expected an unknown resolution while the window is open, got %+v %v", resolution, err)
+	}
+
+	gateway.unsettled = append(gateway.unsettled, summaryXML("2", submittedAt, TransactionStatusAuthorizedPendingCapture, "INV-1", "19.99"))
+	gateway.details["2"] = detailsXML("2", "", TransactionStatusAuthorizedPendingCapture, "INV-1", "19.99")
+	resolution, err = client.ResolveTransaction(resolveRequest("", "INV-1", "19.99"), submittedAt, 10*time.Minute)
+	if err != nil || resolution.Status != ResolutionCharged || resolution.TransId != "2" {
+		t.Errorf("expected a charge to be reported while the window is open, got %+v %v", resolution, err)
+	}
+}
+
+func TestResolveTransactionSplitsSettledBatchRange(t *testing.T) {
+	submittedAt := time.Now().Add(-70 * 24 * time.Hour)
+	gateway := &fakeReporting{
+		batches: map[string][]string{"b1": {summaryXML("1", submittedAt, TransactionStatusSettledSuccessfully, "INV-1", "19.99")}},
+		details: map[string]string{"1": detailsXML("1", "", TransactionStatusSettledSuccessfully, "INV-1", "19.99")},
+	}
+	client := newFakeClient(t, gateway.ServeHTTP)
+	resolution, err := client.ResolveTransaction(resolveRequest("", "INV-1", "19.99"), submittedAt, 10*time.Minute)
+	if err != nil || resolution.Status != ResolutionCharged || resolution.TransId != "1" {
+		t.Errorf("expected a charge, got %+v %v", resolution, err)
+	}
+	if len(gateway.detailRequests) != 1 {
+		t.Errorf("expected the batch to be searched once, requested details of %v", gateway.detailRequests)
+	}
+
+	ranges := gateway.settlementRanges
+	if len(ranges) != 3 {
+		t.Fatalf("expected 3 settled batch list requests, got %v", ranges)
+	}
+	previous := submittedAt.Add(-10 * time.Minute).UTC().Truncate(time.Second)
+	for _, r := range ranges {
+		first, fErr := time.Parse(time.RFC3339, r[0])
+		last, lErr := time.Parse(time.RFC3339, r[1])
+		if fErr != nil || lErr != nil {
+			t.Fatalf("unable to parse settlement range %v", r)
+		}
+		if !first.Equal(previous) || last.Sub(first) > 31*24*time.Hour {
+			t.Errorf("expected a range of at most 31 days from %s, got %v", previous, r)
+		}
+		previous = last
+	}
+	if time.Since(previous) > time.Minute {
+		t.Errorf("expected the last range to end now, got %s", previous)
+	}
+}
+
+func TestResolveTransactionRequiresIdentifier(t *testing.T) {
+	client := newFakeClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request")
+	})
+	resolution, err := client.ResolveTransaction(resolveRequest("", "", "19.99"), time.Now(), time.Minute)
+	if err == nil || resolution.Status != ResolutionUnknown {
+		t.Errorf("expected an error without invoice number or refId, got %+v %v", resolution, err)
+	}
+}
+
+func TestSummaryMatchesAmount(t *testing.T) {
+	partial := resolveRequest("", "INV-1", "20.00").TransactionRequestType
+	partial.TransactionSettings = &ArrayOfSetting{Setting: []SettingType{{SettingName: "allowPartialAuth", SettingValue: "true"}}}
+	cases := []struct {
+		name    string
+		t       TransactionRequestType
+		settled string
+		matches bool
+	}{
+		{"equal", resolveRequest("", "", "20.00").TransactionRequestType, "20.00", true},
+		{"zero", resolveRequest("", "", "20.00").TransactionRequestType, "0.00", true},
+		{"lower", resolveRequest("", "", "20.00").TransactionRequestType, "15.00", false},
+		{"higher", resolveRequest("", "", "20.00").TransactionRequestType, "25.00", false},
+		{"partial lower", partial, "15.00", true},
+		{"partial higher", partial, "25.00", false},
+		{"no amount", TransactionRequestType{}, "25.00", true},
+	}
+	for _, c := range cases {
+		if matches := summaryMatchesAmount(c.t, TransactionSummaryType{SettleAmount: MustParseAmount(c.settled)}); matches != c.matches {
+			t.Errorf("%s: expected %t, got %t", c.name, c.matches, matches)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package authnet
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"reflect"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	DefaultRetryInitialBackoff = 500 * time.Millisecond
+	DefaultRetryMaxBackoff     = 10 * time.Second
+)
+
+type retryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// policy applies the defaults to the configured retry values. A nil Retry makes a single attempt.
+func (r *Retry) policy() retryPolicy {
+	policy := retryPolicy{
+		MaxAttempts:    1,
+		InitialBackoff: DefaultRetryInitialBackoff,
+		MaxBackoff:     DefaultRetryMaxBackoff,
+	}
+	if r == nil {
+		return policy
+	}
+	if r.MaxAttempts > 1 {
+		policy.MaxAttempts = r.MaxAttempts
+	}
+	if r.InitialBackoffMs > 0 {
+		policy.InitialBackoff = time.Duration(r.InitialBackoffMs) * time.Millisecond
+	}
+	if r.MaxBackoffMs > 0 {
+		policy.MaxBackoff = time.Duration(r.MaxBackoffMs) * time.Millisecond
+	}
+	return policy
+}
+
+// wait sleeps for the exponential backoff of the attempt with jitter, between half and all of the backoff. It returns
+// early with the context error when ctx is done.
+func (p retryPolicy) wait(ctx context.Context, attempt int) error {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	backoff = backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// shouldRetry decides whether the attempt that produced res and rErr can safely be repeated. See Retry.
+func shouldRetry(req any, res any, rErr *RequestError) bool {
+	name := requestName(req)
+	createTransaction := name == "createTransactionRequest"
+	readOnly := strings.HasPrefix(name, "get") || strings.HasPrefix(name, "ARBGet") || name == "authenticateTestRequest"
+	duplicateSafe := !createTransaction || isDuplicateProtected(req)
+
+	if rErr == nil {
+		return hasTransientCode(res) && duplicateSafe
+	}
+	if rErr.Response != nil {
+		return hasTransientMessage(rErr.Codes()) && duplicateSafe
+	}
+	if isDialError(rErr.Err) {
+		return true
+	}
+	if rErr.StatusCode >= 500 {
+		return readOnly || createTransaction && duplicateSafe
+	}
+	if rErr.StatusCode == 0 && isTransportError(rErr.Err) {
+		return readOnly || createTransaction && duplicateSafe
+	}
+	return false
+}
+
+// requestName returns the XML element name of the request, such as createTransactionRequest.
+func requestName(req any) string {
+	value := reflect.Indirect(reflect.ValueOf(req))
+	if value.Kind() != reflect.Struct {
+		return ""
+	}
+	if field, ok := value.Type().FieldByName("XMLName"); ok && field.Type == reflect.TypeOf(xml.Name{}) {
+		tag := field.Tag.Get("xml")
+		return tag[strings.LastIndex(tag, " ")+1:]
+	}
+	return ""
+}
+
+// isDuplicateProtected reports whether a repeated transaction request would be caught by the gateway duplicate check.
+// The check compares the invoice number along with the amount and payment details; the refId is not part of it.
+func isDuplicateProtected(req any) bool {
+	var transaction CreateTransactionRequestType
+	switch r := req.(type) {
+	case CreateTransactionRequestType:
+		transaction = r
+	case *CreateTransactionRequestType:
+		transaction = *r
+	default:
+		return false
+	}
+	t := transaction.TransactionRequestType
+	if t.Order == nil || len(t.Order.InvoiceNumber) == 0 {
+		return false
+	}
+	if t.TransactionSettings != nil {
+		for _, setting := range t.TransactionSettings.Setting {
+			if setting.SettingName == "duplicateWindow" && strings.TrimSpace(setting.SettingValue) == "0" {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// hasTransientMessage reports whether any of the messages is Retryable in the code catalogue.
+func hasTransientMessage(messages []Message) bool {
+	for _, message := range messages {
+		if info, _ := LookupMessageCode(message.Code); info.Retryable {
+			return true
+		}
+	}
+	return false
+}
+
+// hasTransientCode checks a response with an Error result code for temporary API errors. The reason codes of a
+// transaction response are not considered: the transaction was processed, and repeating it within the duplicate window
+// is rejected with reason code 11.
+func hasTransientCode(res any) bool {
+	response, ok := res.(apiResponse)
+	if !ok {
+		return false
+	}
+	messages := response.apiResponse().Messages
+	if messages == nil || !strings.EqualFold(messages.ResultCode, MessageTypeError) {
+		return false
+	}
+	return hasTransientMessage(messages.Message)
+}
+
+// isDialError reports whether the connection could not be established, in which case nothing was sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
+}
+
+// isTransportError reports whether the connection failed while the request or the response was in flight: it was
+// closed or reset by the gateway, or reading or writing it timed out. Failures before anything was sent that will fail
+// again, such as an invalid URL or certificate, and context errors are not.
+func isTransportError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && (opErr.Op == "read" || opErr.Op == "write") {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// resetResponse zeroes res before it is unmarshalled into again, since unmarshalling appends to slices.
+func resetResponse(res any) {
+	value := reflect.ValueOf(res)
+	if value.Kind() == reflect.Pointer && !value.IsNil() {
+		value.Elem().Set(reflect.Zero(value.Elem().Type()))
+	}
+}
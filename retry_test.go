@@ -0,0 +1,194 @@
+package authnet
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func transactionRequest(refId string, invoiceNumber string, settings ...SettingType) CreateTransactionRequestType {
+	req := CreateTransactionRequestType{ANetApiRequest: ANetApiRequest{RefId: refId}}
+	if len(invoiceNumber) > 0 {
+		req.TransactionRequestType.Order = &OrderType{InvoiceNumber: invoiceNumber}
+	}
+	if len(settings) > 0 {
+		req.TransactionRequestType.TransactionSettings = &ArrayOfSetting{Setting: settings}
+	}
+	return req
+}
+
+// httpError wraps err the way post does when http.Client.Do fails.
+func httpError(err error) *RequestError {
+	return &RequestError{Err: &TransportError{Err: errors.Join(errors.New("unable to make http request"),
+		&url.Error{Op: "Post", URL: "https://api.authorize.net/xml/v1/request.api", Err: err})}}
+}
+
+func statusError(status int) *RequestError {
+	return &RequestError{StatusCode: status, Err: &TransportError{Err: errors.New("unexpected http status")}}
+}
+
+func messagesError(code string) *RequestError {
+	return &RequestError{Response: &ErrorResponse{Messages: Messages{
+		ResultCode: MessageTypeError,
+		Message:    []Message{{Code: code}},
+	}}}
+}
+
+func TestShouldRetry(t *testing.T) {
+	read := GetTransactionDetailsRequest{}
+	write := CreateCustomerProfileRequest{}
+	protected := transactionRequest("", "INV-1")
+	refIdOnly := transactionRequest("ref-1", "")
+	windowDisabled := transactionRequest("", "INV-1", SettingType{SettingName: "duplicateWindow", SettingValue: "0"})
+
+	dial := httpError(&net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)})
+	dns := httpError(&net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "api.authorize.net"}})
+	reset := httpError(&net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)})
+	eof := httpError(io.EOF)
+	timeout := httpError(os.ErrDeadlineExceeded)
+	certificate := httpError(&tls.CertificateVerificationError{Err: x509.UnknownAuthorityError{}})
+	badUrl := httpError(errors.New(`unsupported protocol scheme "ftp"`))
+	canceled := httpError(context.Canceled)
+	deadline := httpError(context.DeadlineExceeded)
+
+	transientResponse := &GetTransactionDetailsResponse{ANetApiResponse: ANetApiResponse{Messages: &MessagesType{
+		ResultCode: MessageTypeError,
+		Message:    []Message{{Code: "E00053"}},
+	}}}
+	declinedTransaction := &CreateTransactionResponse{
+		ANetApiResponse: ANetApiResponse{Messages: &MessagesType{
+			ResultCode: MessageTypeError,
+			Message:    []Message{{Code: "E00027"}},
+		}},
+		TransactionResponse: TransactionResponse{Errors: &Errors{Error: []Error{{ErrorCode: "19"}}}},
+	}
+	okResponse := &GetTransactionDetailsResponse{ANetApiResponse: ANetApiResponse{Messages: &MessagesType{
+		ResultCode: MessageTypeOk,
+	}}}
+
+	cases := []struct {
+		name  string
+		req   any
+		res   any
+		rErr  *RequestError
+		retry bool
+	}{
+		{"read dial", read, nil, dial, true},
+		{"write dial", write, nil, dial, true},
+		{"unprotected transaction dial", refIdOnly, nil, dns, true},
+		{"read 503", read, nil, statusError(503), true},
+		{"write 503", write, nil, statusError(503), false},
+		{"protected transaction 503", protected, nil, statusError(503), true},
+		{"protected transaction pointer 503", &protected, nil, statusError(503), true},
+		{"refId transaction 503", refIdOnly, nil, statusError(503), false},
+		{"window disabled transaction 503", windowDisabled, nil, statusError(503), false},
+		{"read 400", read, nil, statusError(400), false},
+		{"read reset", read, nil, reset, true},
+		{"write reset", write, nil, reset, false},
+		{"protected transaction reset", protected, nil, reset, true},
+		{"refId transaction reset", refIdOnly, nil, reset, false},
+		{"read eof", read, nil, eof, true},
+		{"read timeout", read, nil, timeout, true},
+		{"read certificate", read, nil, certificate, false},
+		{"read bad url", read, nil, badUrl, false},
+		{"read canceled", read, nil, canceled, false},
+		{"read deadline", read, nil, deadline, false},
+		{"read E00001", read, nil, messagesError("E00001"), true},
+		{"read E00007", read, nil, messagesError("E00007"), false},
+		{"write E00104", write, nil, messagesError("E00104"), true},
+		{"protected transaction E00001", protected, nil, messagesError("E00001"), true},
+		{"refId transaction E00001", refIdOnly, nil, messagesError("E00001"), false},
+		{"read transient result code", read, transientResponse, nil, true},
+		{"read ok", read, okResponse, nil, false},
+		{"protected transaction retryable reason code", protected, declinedTransaction, nil, false},
+		{"validation", read, nil, &RequestError{Err: &ValidationError{}}, false},
+	}
+	for _, c := range cases {
+		if retry := shouldRetry(c.req, c.res, c.rErr); retry != c.retry {
+			t.Errorf("%s: expected retry %t, got %t", c.name, c.retry, retry)
+		}
+	}
+}
+
+func TestIsDuplicateProtected(t *testing.T) {
+	protected := transactionRequest("", "INV-1")
+	cases := []struct {
+		name      string
+		req       any
+		protected bool
+	}{
+		{"invoice number", protected, true},
+		{"pointer", &protected, true},
+		{"refId only", transactionRequest("ref-1", ""), false},
+		{"empty order", CreateTransactionRequestType{TransactionRequestType: TransactionRequestType{Order: &OrderType{}}}, false},
+		{"nothing", transactionRequest("", ""), false},
+		{"window disabled", transactionRequest("", "INV-1", SettingType{SettingName: "duplicateWindow", SettingValue: " 0 "}), false},
+		{"window shortened", transactionRequest("", "INV-1", SettingType{SettingName: "duplicateWindow", SettingValue: "30"}), true},
+		{"other setting", transactionRequest("", "INV-1", SettingType{SettingName: "emailCustomer", SettingValue: "0"}), true},
+		{"other request", CreateCustomerProfileRequest{}, false},
+	}
+	for _, c := range cases {
+		if protected := isDuplicateProtected(c.req); protected != c.protected {
+			t.Errorf("%s: expected %t, got %t", c.name, c.protected, protected)
+		}
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	cases := []struct {
+		name   string
+		retry  *Retry
+		policy retryPolicy
+	}{
+		{"nil", nil, retryPolicy{1, DefaultRetryInitialBackoff, DefaultRetryMaxBackoff}},
+		{"zero", &Retry{}, retryPolicy{1, DefaultRetryInitialBackoff, DefaultRetryMaxBackoff}},
+		{"negative", &Retry{MaxAttempts: -1, InitialBackoffMs: -1, MaxBackoffMs: -1}, retryPolicy{1, DefaultRetryInitialBackoff, DefaultRetryMaxBackoff}},
+		{"attempts", &Retry{MaxAttempts: 3}, retryPolicy{3, DefaultRetryInitialBackoff, DefaultRetryMaxBackoff}},
+		{"all", &Retry{MaxAttempts: 5, InitialBackoffMs: 100, MaxBackoffMs: 2000}, retryPolicy{5, 100 * time.Millisecond, 2 * time.Second}},
+	}
+	for _, c := range cases {
+		if policy := c.retry.policy(); policy != c.policy {
+			t.Errorf("%s: expected %+v, got %+v", c.name, c.policy, policy)
+		}
+	}
+}
+
+func TestRetryPolicyWait(t *testing.T) {
+	policy := retryPolicy{MaxAttempts: 5, InitialBackoff: 20 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+	cases := []struct {
+		attempt int
+		min     time.Duration
+		max     time.Duration
+	}{
+		{1, 10 * time.Millisecond, 20 * time.Millisecond},
+		{2, 20 * time.Millisecond, 40 * time.Millisecond},
+		{3, 25 * time.Millisecond, 50 * time.Millisecond},
+		{10, 25 * time.Millisecond, 50 * time.Millisecond},
+	}
+	for _, c := range cases {
+		start := time.Now()
+		if err := policy.wait(context.Background(), c.attempt); err != nil {
+			t.Fatal(err)
+		}
+		// The upper bound leaves room for a slow scheduler.
+		if elapsed := time.Since(start); elapsed < c.min || elapsed > c.max+time.Second {
+			t.Errorf("attempt %d: expected a wait between %s and %s, got %s", c.attempt, c.min, c.max, elapsed)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	long := retryPolicy{InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+	if err := long.wait(ctx, 1); !errors.Is(err, context.Canceled) || time.Since(start) > time.Second {
+		t.Errorf("expected wait to return the context error immediately, got %v", err)
+	}
+}
@@ -0,0 +1,233 @@
+package authnet
+
+import (
+	"context"
+	"errors"
+)
+
+// CreateTransaction submits a payment transaction. Declined and errored transactions return a *RequestError along
+// with the response, so TransactionResponse can still be inspected for the reason. The RequestError carries the
+// transaction errors, so errors.Is matches sentinels such as ErrDuplicateTransaction.
+func (c *AuthNetClient) CreateTransaction(req CreateTransactionRequestType) (*CreateTransactionResponse, error) {
+	return c.CreateTransactionContext(context.Background(), req)
+}
+
+// CreateTransactionContext is like CreateTransaction but uses ctx for the request.
+func (c *AuthNetClient) CreateTransactionContext(ctx context.Context, req CreateTransactionRequestType) (*CreateTransactionResponse, error) {
+	var res CreateTransactionResponse
+	if err := c.send(ctx, req, &res); err != nil {
+		var requestError *RequestError
+		if errors.As(err, &requestError) && requestError.Response != nil && requestError.Err == nil {
+			if res.TransactionResponse.Errors != nil {
+				requestError.TransactionErrors = res.TransactionResponse.Errors.Error
+			}
+			return &res, err
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func newCreateTransactionRequest(auth MerchantAuthenticationType, transaction TransactionRequestType) CreateTransactionRequestType {
+	return CreateTransactionRequestType{
+		ANetApiRequest: ANetApiRequest{
+			MerchantAuthentication: auth,
+		},
+		TransactionRequestType: transaction,
+	}
+}
+
+// NewAuthCaptureTransaction creates a request that authorizes and captures the amount in a single step. The remaining
+// optional fields, such as Order or BillTo, may be set on the returned request.
+func NewAuthCaptureTransaction(auth MerchantAuthenticationType, amount Amount, payment PaymentType) CreateTransactionRequestType {
+	return newCreateTransactionRequest(auth, TransactionRequestType{
+		TransactionType: TransactionTypeAuthCaptureTransaction,
+		Amount:          &amount,
+		Payment:         &payment,
+	})
+}
+
+// NewAuthCaptureProfileTransaction creates a request that authorizes and captures the amount by charging a stored
+// customer payment profile.
+func NewAuthCaptureProfileTransaction(auth MerchantAuthenticationType, amount Amount, profile CustomerProfilePaymentType) CreateTransactionRequestType {
+	return newCreateTransactionRequest(auth, TransactionRequestType{
+		TransactionType: TransactionTypeAuthCaptureTransaction,
+		Amount:          &amount,
+		Profile:         &profile,
+	})
+}
+
+// NewAuthOnlyTransaction creates a request that only authorizes the amount. The authorization is captured later with
+// NewPriorAuthCaptureTransaction.
+func NewAuthOnlyTransaction(auth MerchantAuthenticationType, amount Amount, payment PaymentType) CreateTransactionRequestType {
+	return newCreateTransactionRequest(auth, TransactionRequestType{
+		TransactionType: TransactionTypeAuthOnlyTransaction,
+		Amount:          &amount,
+		Payment:         &payment,
+	})
+}
+
+// NewAuthOnlyProfileTransaction creates a request that only authorizes the amount on a stored customer payment
+// profile.
+func NewAuthOnlyProfileTransaction(auth MerchantAuthenticationType, amount Amount, profile CustomerProfilePaymentType) CreateTransactionRequestType {
+	return newCreateTransactionRequest(auth, TransactionRequestType{
+		TransactionType: TransactionTypeAuthOnlyTransaction,
+		Amount:          &amount,
+		Profile:         &profile,
+	})
+}
+
+// NewPriorAuthCaptureTransaction creates a request that captures a previous authorization. A nil amount captures the
+// full authorized amount, otherwise the amount may not exceed it.
+func NewPriorAuthCaptureTransaction(auth MerchantAuthenticationType, refTransId string, amount *Amount) CreateTransactionRequestType {
+	return newCreateTransactionRequest(auth, TransactionRequestType{
+		TransactionType: TransactionTypePriorAuthCaptureTransaction,
+		Amount:          amount,
+		RefTransId:      refTransId,
+	})
+}
+
+// NewCaptureOnlyTransaction creates a request that captures a transaction authorized outside the gateway, such as a
+// voice authorization, using the authorization code obtained from the issuer.
+func NewCaptureOnlyTransaction(auth MerchantAuthenticationType, amount Amount, authCode string, payment PaymentType) CreateTransactionRequestType {
+	return newCreateTransactionRequest(auth, TransactionRequestType{
+		TransactionType: TransactionTypeCaptureOnlyTransaction,
+		Amount:          &amount,
+		Payment:         &payment,
+		AuthCode:        authCode,
+	})
+}
+
+// NewRefundTransaction creates a request that refunds a settled transaction. The gateway requires the last four
+// digits of the card number, such as XXXX1111, and the expiration date, which may be XXXX, of the original transaction.
+func NewRefundTransaction(auth MerchantAuthenticationType, amount Amount, refTransId string, maskedCardNumber string, expirationDate string) CreateTransactionRequestType {
+	return newCreateTransactionRequest(auth, TransactionRequestType{
+		TransactionType: TransactionTypeRefundTransaction,
+		Amount:          &amount,
+		Payment: &PaymentType{
+			CreditCard: &CreditCardType{
+				CreditCardSimpleType: CreditCardSimpleType{
+					CardNumber:     maskedCardNumber,
+					ExpirationDate: expirationDate,
+				},
+			},
+		},
+		RefTransId: refTransId,
+	})
+}
+
+// NewBankAccountRefundTransaction creates a request that refunds a settled eCheck.Net transaction to the bank account
+// of the original transaction.
+func NewBankAccountRefundTransaction(auth MerchantAuthenticationType, amount Amount, refTransId string, bankAccount BankAccountType) CreateTransactionRequestType {
+	return newCreateTransactionRequest(auth, TransactionRequestType{
+		TransactionType: TransactionTypeRefundTransaction,
+		Amount:          &amount,
+		Payment: &PaymentType{
+			BankAccount: &bankAccount,
+		},
+		RefTransId: refTransId,
+	})
+}
+
+// NewProfileRefundTransaction creates a request that refunds a settled transaction to a stored customer payment
+// profile.
+func NewProfileRefundTransaction(auth MerchantAuthenticationType, amount Amount, refTransId string, profile CustomerProfilePaymentType) CreateTransactionRequestType {
+	return newCreateTransactionRequest(auth, TransactionRequestType{
+		TransactionType: TransactionTypeRefundTransaction,
+		Amount:          &amount,
+		Profile:         &profile,
+		RefTransId:      refTransId,
+	})
+}
+
+// NewVoidTransaction creates a request that voids an unsettled transaction. Voids always apply to the full amount.
+func NewVoidTransaction(auth MerchantAuthenticationType, refTransId string) CreateTransactionRequestType {
+	return newCreateTransactionRequest(auth, TransactionRequestType{
+		TransactionType: TransactionTypeVoidTransaction,
+		RefTransId:      refTransId,
+	})
+}
+
+// NewPayPalAuthCaptureTransaction creates a request that starts a PayPal Express Checkout sale. The customer is sent
+// to TransactionResponse.SecureAcceptance.SecureAcceptanceUrl and the sale is completed with
+// NewPayPalAuthCaptureContinueTransaction.
+func NewPayPalAuthCaptureTransaction(auth MerchantAuthenticationType, amount Amount, successUrl string, cancelUrl string) CreateTransactionRequestType {
+	return newCreateTransactionRequest(auth, TransactionRequestType{
+		TransactionType: TransactionTypeAuthCaptureTransaction,
+		Amount:          &amount,
+		Payment: &PaymentType{
+			PayPal: &PayPalType{
+				SuccessUrl: successUrl,
+				CancelUrl:  cancelUrl,
+			},
+		},
+	})
+}
+
+// NewPayPalAuthOnlyTransaction creates a request that starts a PayPal Express Checkout authorization which is
+// completed with NewPayPalAuthOnlyContinueTransaction.
+func NewPayPalAuthOnlyTransaction(auth MerchantAuthenticationType, amount Amount, successUrl string, cancelUrl string) CreateTransactionRequestType {
+	return newCreateTransactionRequest(auth, TransactionRequestType{
+		TransactionType: TransactionTypeAuthOnlyTransaction,
+		Amount:          &amount,
+		Payment: &PaymentType{
+			PayPal: &PayPalType{
+				SuccessUrl: successUrl,
+				CancelUrl:  cancelUrl,
+			},
+		},
+	})
+}
+
+// NewPayPalAuthCaptureContinueTransaction creates a request that completes a PayPal sale after the customer approved
+// it. The payer ID is returned to the success URL.
+func NewPayPalAuthCaptureContinueTransaction(auth MerchantAuthenticationType, refTransId string, payerId string) CreateTransactionRequestType {
+	return newCreateTransactionRequest(auth, TransactionRequestType{
+		TransactionType: TransactionTypeAuthCaptureContinueTransaction,
+		Payment: &PaymentType{
+			PayPal: &PayPalType{
+				PayerId: payerId,
+			},
+		},
+		RefTransId: refTransId,
+	})
+}
+
+// NewPayPalAuthOnlyContinueTransaction creates a request that completes a PayPal authorization after the customer
+// approved it. The payer ID is returned to the success URL.
+func NewPayPalAuthOnlyContinueTransaction(auth MerchantAuthenticationType, refTransId string, payerId string) CreateTransactionRequestType {
+	return newCreateTransactionRequest(auth, TransactionRequestType{
+		TransactionType: TransactionTypeAuthOnlyContinueTransaction,
+		Payment: &PaymentType{
+			PayPal: &PayPalType{
+				PayerId: payerId,
+			},
+		},
+		RefTransId: refTransId,
+	})
+}
+
+// NewGetDetailsTransaction creates a request that retrieves the shipping address and payer details of a PayPal
+// transaction the customer has approved.
+func NewGetDetailsTransaction(auth MerchantAuthenticationType, refTransId string) CreateTransactionRequestType {
+	return newCreateTransactionRequest(auth, TransactionRequestType{
+		TransactionType: TransactionTypeGetDetailsTransaction,
+		Payment: &PaymentType{
+			PayPal: &PayPalType{},
+		},
+		RefTransId: refTransId,
+	})
+}
+
+// NewVisaCheckoutTransaction creates a request that charges a Visa Checkout payment. The call ID and the encrypted
+// payment data are returned by the Visa Checkout button.
+func NewVisaCheckoutTransaction(auth MerchantAuthenticationType, transactionType TransactionTypeEnum, amount Amount, callId string, opaqueData OpaqueDataType) CreateTransactionRequestType {
+	return newCreateTransactionRequest(auth, TransactionRequestType{
+		TransactionType: transactionType,
+		Amount:          &amount,
+		Payment: &PaymentType{
+			OpaqueData: &opaqueData,
+		},
+		CallId: callId,
+	})
+}
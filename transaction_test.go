@@ -0,0 +1,169 @@
+package authnet
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestTransactionConstructors(t *testing.T) {
+	amount := MustParseAmount("15.00")
+	card := PaymentType{CreditCard: &CreditCardType{
+		CreditCardSimpleType: CreditCardSimpleType{CardNumber: "4111111111111111", ExpirationDate: "2030-12"},
+	}}
+	profile := CustomerProfilePaymentType{CustomProfileId: "10", PaymentProfile: &PaymentProfile{PaymentProfileId: "20"}}
+	bankAccount := BankAccountType{RoutingNumber: "XXXX0021", AccountNumber: "XXXX6789", NameOnAccount: "John Doe"}
+	opaqueData := OpaqueDataType{DataDescriptor: "COMMON.VCO.ONLINE.PAYMENT", DataValue: "encrypted", DataKey: "key"}
+
+	const cardElements = `<payment><creditCard><cardNumber>4111111111111111</cardNumber><expirationDate>2030-12</expirationDate>` +
+		`</creditCard></payment>`
+	const profileElements = `<profile><customerProfileId>10</customerProfileId><paymentProfile>` +
+		`<paymentProfileId>20</paymentProfileId></paymentProfile></profile>`
+	cases := []struct {
+		name     string
+		req      CreateTransactionRequestType
+		elements []string
+		absent   []string
+	}{
+		{
+			"auth capture",
+			NewAuthCaptureTransaction(testAuth, amount, card),
+			[]string{"<transactionType>authCaptureTransaction</transactionType>", "<amount>15.00</amount>", cardElements},
+			[]string{"<profile>", "<refTransId>"},
+		},
+		{
+			"auth capture profile",
+			NewAuthCaptureProfileTransaction(testAuth, amount, profile),
+			[]string{"<transactionType>authCaptureTransaction</transactionType>", "<amount>15.00</amount>", profileElements},
+			[]string{"<payment>", "<refTransId>"},
+		},
+		{
+			"auth only",
+			NewAuthOnlyTransaction(testAuth, amount, card),
+			[]string{"<transactionType>authOnlyTransaction</transactionType>", "<amount>15.00</amount>", cardElements},
+			[]string{"<profile>", "<refTransId>"},
+		},
+		{
+			"auth only profile",
+			NewAuthOnlyProfileTransaction(testAuth, amount, profile),
+			[]string{"<transactionType>authOnlyTransaction</transactionType>", "<amount>15.00</amount>", profileElements},
+			[]string{"<payment>", "<refTransId>"},
+		},
+		{
+			"prior auth capture of the full amount",
+			NewPriorAuthCaptureTransaction(testAuth, "60000000001", nil),
+			[]string{"<transactionType>priorAuthCaptureTransaction</transactionType>", "<refTransId>60000000001</refTransId>"},
+			[]string{"<amount>", "<payment>"},
+		},
+		{
+			"prior auth capture of a lower amount",
+			NewPriorAuthCaptureTransaction(testAuth, "60000000001", &amount),
+			[]string{"<transactionType>priorAuthCaptureTransaction</transactionType>", "<amount>15.00</amount>",
+				"<refTransId>60000000001</refTransId>"},
+			[]string{"<payment>"},
+		},
+		{
+			"capture only",
+			NewCaptureOnlyTransaction(testAuth, amount, "ABC123", card),
+			[]string{"<transactionType>captureOnlyTransaction</transactionType>", "<amount>15.00</amount>", cardElements,
+				"<authCode>ABC123</authCode>"},
+			[]string{"<refTransId>"},
+		},
+		{
+			"refund",
+			NewRefundTransaction(testAuth, amount, "60000000001", "XXXX1111", "XXXX"),
+			[]string{"<transactionType>refundTransaction</transactionType>", "<amount>15.00</amount>",
+				"<payment><creditCard><cardNumber>XXXX1111</cardNumber><expirationDate>XXXX</expirationDate></creditCard></payment>",
+				"<refTransId>60000000001</refTransId>"},
+			[]string{"<profile>", "<cardCode>"},
+		},
+		{
+			"bank account refund",
+			NewBankAccountRefundTransaction(testAuth, amount, "60000000001", bankAccount),
+			[]string{"<transactionType>refundTransaction</transactionType>", "<amount>15.00</amount>",
+				"<payment><bankAccount><routingNumber>XXXX0021</routingNumber><accountNumber>XXXX6789</accountNumber>",
+				"<refTransId>60000000001</refTransId>"},
+			[]string{"<creditCard>", "<profile>"},
+		},
+		{
+			"profile refund",
+			NewProfileRefundTransaction(testAuth, amount, "60000000001", profile),
+			[]string{"<transactionType>refundTransaction</transactionType>", "<amount>15.00</amount>", profileElements,
+				"<refTransId>60000000001</refTransId>"},
+			[]string{"<payment>"},
+		},
+		{
+			"void",
+			NewVoidTransaction(testAuth, "60000000001"),
+			[]string{"<transactionType>voidTransaction</transactionType>", "<refTransId>60000000001</refTransId>"},
+			[]string{"<amount>", "<payment>", "<profile>"},
+		},
+		{
+			"PayPal auth capture",
+			NewPayPalAuthCaptureTransaction(testAuth, amount, "https://example.com/ok", "https://example.com/cancel"),
+			[]string{"<transactionType>authCaptureTransaction</transactionType>", "<amount>15.00</amount>",
+				"<payment><payPal><successUrl>https://example.com/ok</successUrl><cancelUrl>https://example.com/cancel</cancelUrl>" +
+					"</payPal></payment>"},
+			[]string{"<refTransId>", "<payerId>"},
+		},
+		{
+			"PayPal auth only",
+			NewPayPalAuthOnlyTransaction(testAuth, amount, "https://example.com/ok", "https://example.com/cancel"),
+			[]string{"<transactionType>authOnlyTransaction</transactionType>", "<amount>15.00</amount>",
+				"<payment><payPal><successUrl>https://example.com/ok</successUrl><cancelUrl>https://example.com/cancel</cancelUrl>" +
+					"</payPal></payment>"},
+			[]string{"<refTransId>", "<payerId>"},
+		},
+		{
+			"PayPal auth capture continue",
+			NewPayPalAuthCaptureContinueTransaction(testAuth, "60000000001", "PAYER"),
+			[]string{"<transactionType>authCaptureContinueTransaction</transactionType>",
+				"<payment><payPal><payerId>PAYER</payerId></payPal></payment>", "<refTransId>60000000001</refTransId>"},
+			[]string{"<amount>", "<successUrl>"},
+		},
+		{
+			"PayPal auth only continue",
+			NewPayPalAuthOnlyContinueTransaction(testAuth, "60000000001", "PAYER"),
+			[]string{"<transactionType>authOnlyContinueTransaction</transactionType>",
+				"<payment><payPal><payerId>PAYER</payerId></payPal></payment>", "<refTransId>60000000001</refTransId>"},
+			[]string{"<amount>", "<successUrl>"},
+		},
+		{
+			"get details",
+			NewGetDetailsTransaction(testAuth, "60000000001"),
+			[]string{"<transactionType>getDetailsTransaction</transactionType>", "<payment><payPal></payPal></payment>",
+				"<refTransId>60000000001</refTransId>"},
+			[]string{"<amount>", "<payerId>"},
+		},
+		{
+			"Visa Checkout",
+			NewVisaCheckoutTransaction(testAuth, TransactionTypeAuthOnlyTransaction, amount, "CALL-1", opaqueData),
+			[]string{"<transactionType>authOnlyTransaction</transactionType>", "<amount>15.00</amount>",
+				"<payment><opaqueData><dataDescriptor>COMMON.VCO.ONLINE.PAYMENT</dataDescriptor><dataValue>encrypted</dataValue>" +
+					"<dataKey>key</dataKey></opaqueData></payment>",
+				"<callId>CALL-1</callId>"},
+			[]string{"<refTransId>", "<creditCard>"},
+		},
+	}
+	prefix := `<createTransactionRequest xmlns="AnetApi/xml/v1/schema/AnetApiSchema.xsd"><merchantAuthentication>` +
+		`<name>login</name><transactionKey>key</transactionKey></merchantAuthentication><transactionRequest>`
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			body, err := xml.Marshal(c.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			encoded := string(body)
+			if !strings.HasPrefix(encoded, prefix) {
+				t.Fatalf("unexpected request %s", encoded)
+			}
+			// The elements are listed in the order of the XSD, which the gateway enforces.
+			assertFragments(t, encoded, c.elements...)
+			for _, element := range c.absent {
+				if strings.Contains(encoded, element) {
+					t.Errorf("expected no %s in %s", element, encoded)
+				}
+			}
+		})
+	}
+}
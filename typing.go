@@ -1,8 +1,14 @@
 package authnet
 
-func Float64RefFromInt(value int) *float64 {
-	f := float64(value)
-	return &f
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+func AmountRef(amount Amount) *Amount {
+	return &amount
 }
 
 func BoolTrueRef() *bool {
@@ -14,3 +20,86 @@ func BoolFalseRef() *bool {
 	b := false
 	return &b
 }
+
+// Date is a calendar date that marshals to the xs:date format (such as 2001-10-26) expected by the API.
+type Date struct {
+	time.Time
+}
+
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.Format(dateLayout)), nil
+}
+
+func (d *Date) UnmarshalText(text []byte) error {
+	value := strings.TrimSpace(string(text))
+	for _, layout := range []string{dateLayout, dateLayout + "Z07:00"} {
+		if parsed, pErr := time.Parse(layout, value); pErr == nil {
+			d.Time = parsed
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid date %q", value)
+}
+
+// MarshalJSON uses the xs:date format, overriding the method promoted from time.Time.
+func (d Date) MarshalJSON() ([]byte, error) {
+	text, _ := d.MarshalText()
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON accepts the formats of UnmarshalText, overriding the method promoted from time.Time.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONText(data, d.UnmarshalText)
+}
+
+// DateTime is a point in time in the xs:dateTime format. Unlike time.Time it accepts values without a time zone, which
+// the API returns for local times and some UTC times, and treats them as UTC.
+type DateTime struct {
+	time.Time
+}
+
+func (d DateTime) MarshalText() ([]byte, error) {
+	return []byte(d.Format(time.RFC3339Nano)), nil
+}
+
+func (d *DateTime) UnmarshalText(text []byte) error {
+	value := strings.TrimSpace(string(text))
+	for _, layout := range []string{time.RFC3339Nano, dateTimeLayout} {
+		if parsed, pErr := time.Parse(layout, value); pErr == nil {
+			d.Time = parsed
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid date time %q", value)
+}
+
+// MarshalJSON uses the xs:dateTime format, overriding the method promoted from time.Time.
+func (d DateTime) MarshalJSON() ([]byte, error) {
+	text, _ := d.MarshalText()
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON accepts values without a time zone like UnmarshalText, overriding the method promoted from time.Time.
+func (d *DateTime) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONText(data, d.UnmarshalText)
+}
+
+func unmarshalJSONText(data []byte, unmarshalText func(text []byte) error) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return unmarshalText([]byte(s))
+}
+
+const (
+	dateLayout     = "2006-01-02"
+	dateTimeLayout = "2006-01-02T15:04:05.999999999"
+)
@@ -0,0 +1,36 @@
+package authnet
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDateTimeJSON(t *testing.T) {
+	var decoded struct {
+		Date     Date     `json:"date"`
+		DateTime DateTime `json:"dateTime"`
+		Zoned    DateTime `json:"zoned"`
+	}
+	data := `{"date":"2017-04-04","dateTime":"2017-04-04T16:14:16.7488414","zoned":"2017-04-04T16:14:16-06:00"}`
+	if err := json.Unmarshal([]byte(data), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Date.Equal(time.Date(2017, 4, 4, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected date %s", decoded.Date)
+	}
+	if !decoded.DateTime.Equal(time.Date(2017, 4, 4, 16, 14, 16, 748841400, time.UTC)) {
+		t.Errorf("unexpected date time %s", decoded.DateTime)
+	}
+	if !decoded.Zoned.Equal(time.Date(2017, 4, 4, 22, 14, 16, 0, time.UTC)) {
+		t.Errorf("unexpected zoned date time %s", decoded.Zoned)
+	}
+
+	encoded, mErr := json.Marshal(decoded)
+	if mErr != nil {
+		t.Fatal(mErr)
+	}
+	if string(encoded) != `{"date":"2017-04-04","dateTime":"2017-04-04T16:14:16.7488414Z","zoned":"2017-04-04T16:14:16-06:00"}` {
+		t.Errorf("unexpected encoding %s", encoded)
+	}
+}
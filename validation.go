@@ -0,0 +1,318 @@
+package authnet
+
+import (
+	"encoding"
+	"fmt"
+	"net/mail"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Validator is implemented by types that check rules which cannot be expressed with validation tags. Validate calls it
+// for every value it visits.
+type Validator interface {
+	Validate() error
+}
+
+// FieldError describes a single field that failed validation. Field is the path of XML element names from the request,
+// such as transactionRequest.billTo.zip.
+type FieldError struct {
+	Field   string
+	Rule    string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	if len(e.Field) == 0 {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// ValidationError holds every FieldError found by Validate.
+type ValidationError struct {
+	Errors []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, fieldErr := range e.Errors {
+		messages[i] = fieldErr.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, fieldErr := range e.Errors {
+		errs[i] = fieldErr
+	}
+	return errs
+}
+
+// Validate checks req, and every struct nested within it, against the validation tags of its fields and any Validator
+// implementations. It returns a *ValidationError listing every failure, or nil when req is valid.
+//
+// The supported rules are:
+//   - required: pointers must not be nil, strings and slices must not be empty, and numbers, amounts and structs must
+//     not be zero.
+//   - min=N, max=N: the length of strings and slices or the value of numbers and amounts.
+//   - numeric: strings must only contain digits.
+//   - oneOf=a b c: strings must be one of the space separated values.
+//   - email: strings must be an email address.
+//   - masked: strings masked by the gateway with leading X characters, such as XXXX1234, are only checked against
+//     required.
+//
+// Fields that are empty and not required are not checked against the other rules. Fields behind a pointer that is set
+// always are.
+func Validate(req any) error {
+	var v validator
+	v.walk("", reflect.ValueOf(req))
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return &ValidationError{Errors: v.errors}
+}
+
+type validator struct {
+	errors []*FieldError
+}
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+func (v *validator) fail(path string, rule string, format string, args ...any) {
+	v.errors = append(v.errors, &FieldError{Field: path, Rule: rule, Message: fmt.Sprintf(format, args...)})
+}
+
+// walk visits value and everything nested within it.
+func (v *validator) walk(path string, value reflect.Value) {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return
+		}
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Struct:
+		v.walkStruct(path, value)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			v.walk(fmt.Sprintf("%s[%d]", path, i), value.Index(i))
+		}
+	}
+}
+
+func (v *validator) walkStruct(path string, value reflect.Value) {
+	v.callValidator(path, value)
+	if value.Type().Implements(textMarshalerType) {
+		return
+	}
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		if !field.IsExported() || field.Name == "XMLName" {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("xml"), ",")
+		if name == "-" {
+			continue
+		}
+		fieldValue := value.Field(i)
+		if field.Anonymous && len(name) == 0 {
+			v.walk(path, fieldValue)
+			continue
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+		fieldPath := name
+		if len(path) > 0 {
+			fieldPath = path + "." + name
+		}
+		v.checkRules(fieldPath, fieldValue, field.Tag.Get("validation"))
+		v.walk(fieldPath, fieldValue)
+	}
+}
+
+// callValidator calls Validate when value implements Validator, either directly or through a pointer.
+func (v *validator) callValidator(path string, value reflect.Value) {
+	var target any
+	if value.Type().Implements(reflect.TypeOf((*Validator)(nil)).Elem()) {
+		target = value.Interface()
+	} else if value.CanAddr() && value.Addr().Type().Implements(reflect.TypeOf((*Validator)(nil)).Elem()) {
+		target = value.Addr().Interface()
+	} else {
+		return
+	}
+	err := target.(Validator).Validate()
+	if err == nil {
+		return
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			v.fail(path, "", "%s", e.Error())
+		}
+		return
+	}
+	v.fail(path, "", "%s", err.Error())
+}
+
+func (v *validator) checkRules(path string, value reflect.Value, tag string) {
+	if len(tag) == 0 {
+		return
+	}
+	rules := strings.Split(tag, ",")
+	required, maskable := false, false
+	for _, rule := range rules {
+		switch rule {
+		case "required":
+			required = true
+		case "masked":
+			maskable = true
+		}
+	}
+	// A pointer that is set satisfies required, as its element is sent even when it holds the zero value.
+	dereferenced := false
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			if required {
+				v.fail(path, "required", "is required")
+			}
+			return
+		}
+		value = value.Elem()
+		dereferenced = true
+	}
+	if !dereferenced && isEmpty(value) {
+		if required {
+			v.fail(path, "required", "is required")
+		}
+		return
+	}
+	if maskable && value.Kind() == reflect.String && isMasked(value.String()) {
+		return
+	}
+	for _, rule := range rules {
+		name, arg, _ := strings.Cut(rule, "=")
+		switch name {
+		case "min", "max":
+			v.checkBound(path, value, name, arg)
+		case "numeric", "oneOf", "email":
+			v.checkString(path, value, name, arg)
+		}
+	}
+}
+
+// isMasked reports whether s is a value masked by the gateway, such as the XXXX1234 account numbers returned by
+// GetCustomerPaymentProfile.
+func isMasked(s string) bool {
+	return strings.HasPrefix(s, "X")
+}
+
+// isEmpty reports whether value is empty for the required rule: strings, slices and maps without elements, nil
+// pointers and interfaces, and the zero value of every other kind, such as 0, a zero Amount or an empty struct.
+func isEmpty(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map:
+		return value.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return value.IsNil()
+	case reflect.Invalid:
+		return true
+	}
+	return value.IsZero()
+}
+
+func (v *validator) checkBound(path string, value reflect.Value, rule string, arg string) {
+	bound, parseErr := strconv.ParseFloat(arg, 64)
+	if parseErr != nil {
+		return
+	}
+	var actual float64
+	var unit string
+	if amount, ok := value.Interface().(Amount); ok {
+		actual = amount.Float64()
+	} else {
+		switch value.Kind() {
+		case reflect.String:
+			actual, unit = float64(utf8.RuneCountInString(value.String())), " characters"
+		case reflect.Slice, reflect.Array:
+			actual, unit = float64(value.Len()), " items"
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			actual = float64(value.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			actual = float64(value.Uint())
+		case reflect.Float32, reflect.Float64:
+			actual = value.Float()
+		default:
+			return
+		}
+	}
+	if rule == "min" && actual < bound {
+		v.fail(path, rule, "must be at least %s%s", arg, unit)
+	} else if rule == "max" && actual > bound {
+		v.fail(path, rule, "must be at most %s%s", arg, unit)
+	}
+}
+
+// checkString applies a string rule to value, or to every element when value is a slice of strings.
+func (v *validator) checkString(path string, value reflect.Value, rule string, arg string) {
+	if value.Kind() == reflect.Slice {
+		for i := 0; i < value.Len(); i++ {
+			v.checkString(fmt.Sprintf("%s[%d]", path, i), value.Index(i), rule, arg)
+		}
+		return
+	}
+	if value.Kind() != reflect.String || value.Len() == 0 {
+		return
+	}
+	s := value.String()
+	switch rule {
+	case "numeric":
+		for _, r := range s {
+			if r < '0' || r > '9' {
+				v.fail(path, rule, "must only contain digits")
+				return
+			}
+		}
+	case "oneOf":
+		options := strings.Fields(arg)
+		for _, option := range options {
+			if s == option {
+				return
+			}
+		}
+		v.fail(path, rule, "must be one of %s", strings.Join(options, ", "))
+	case "email":
+		if address, parseErr := mail.ParseAddress(s); parseErr != nil || address.Address != s {
+			v.fail(path, rule, "must be an email address")
+		}
+	}
+}
+
+// choice is a single option of an XSD choice group.
+type choice struct {
+	name string
+	set  bool
+}
+
+// validateChoice returns an error unless exactly one option of the group is set.
+func validateChoice(options ...choice) error {
+	var names, set []string
+	for _, option := range options {
+		names = append(names, option.name)
+		if option.set {
+			set = append(set, option.name)
+		}
+	}
+	switch len(set) {
+	case 1:
+		return nil
+	case 0:
+		return fmt.Errorf("one of %s must be set", strings.Join(names, ", "))
+	default:
+		return fmt.Errorf("only one of %s may be set, found %s", strings.Join(names, ", "), strings.Join(set, " and "))
+	}
+}
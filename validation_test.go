@@ -0,0 +1,164 @@
+package authnet
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type validationAddress struct {
+	Zip     string `xml:"zip" validation:"required,numeric,max=5"`
+	Country string `xml:"country,omitempty" validation:"oneOf=US CA"`
+}
+
+type ValidationBase struct {
+	RefId string `xml:"refId,omitempty" validation:"max=20"`
+}
+
+type validationChild struct {
+	Name string
+	Ok   bool
+}
+
+func (c validationChild) Validate() error {
+	if c.Ok {
+		return nil
+	}
+	return errors.Join(errors.New("first"), errors.New("second"))
+}
+
+type validationRequest struct {
+	ValidationBase
+	XMLName   struct{}            `xml:"request"`
+	Email     string              `xml:"email,omitempty" validation:"email"`
+	Count     int                 `xml:"count" validation:"required,min=1,max=10"`
+	Price     Amount              `xml:"price" validation:"required,min=0.01"`
+	Discount  Amount              `xml:"discount,omitempty" validation:"max=5"`
+	Address   validationAddress   `xml:"address" validation:"required"`
+	ShipTo    *validationAddress  `xml:"shipTo,omitempty"`
+	Tags      []string            `xml:"tag" validation:"oneOf=a b"`
+	Children  []validationChild   `xml:"child"`
+	Submitted *DateTime           `xml:"submitted,omitempty" validation:"required"`
+	Quantity  *int                `xml:"quantity,omitempty" validation:"min=1"`
+	ignored   string              `validation:"required"`
+	Skipped   validationAddress   `xml:"-"`
+	Nested    *[]validationChild  `xml:"nested,omitempty"`
+	Extra     map[string]struct{} `xml:"-"`
+}
+
+func validRequest() validationRequest {
+	return validationRequest{
+		Count:     1,
+		Price:     AmountFromCents(100),
+		Address:   validationAddress{Zip: "12345"},
+		Submitted: &DateTime{},
+	}
+}
+
+func fieldErrors(t *testing.T, err error) map[string]string {
+	t.Helper()
+	failures := map[string]string{}
+	if err == nil {
+		return failures
+	}
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected a *ValidationError, got %T %v", err, err)
+	}
+	for _, fieldErr := range validationErr.Errors {
+		failures[fieldErr.Field] += fieldErr.Rule + ";"
+	}
+	return failures
+}
+
+func TestValidateRules(t *testing.T) {
+	cases := []struct {
+		name     string
+		modify   func(r *validationRequest)
+		failures map[string]string
+	}{
+		{"valid", func(r *validationRequest) {}, map[string]string{}},
+		{"zero int", func(r *validationRequest) { r.Count = 0 }, map[string]string{"count": "required;"}},
+		{"int below min", func(r *validationRequest) { r.Count = -1 }, map[string]string{"count": "min;"}},
+		{"int above max", func(r *validationRequest) { r.Count = 11 }, map[string]string{"count": "max;"}},
+		{"zero amount", func(r *validationRequest) { r.Price = Amount{} }, map[string]string{"price": "required;"}},
+		{"amount below min", func(r *validationRequest) { r.Price = AmountFromCents(-1) }, map[string]string{"price": "min;"}},
+		{"optional amount above max", func(r *validationRequest) { r.Discount = AmountFromCents(501) }, map[string]string{"discount": "max;"}},
+		{"empty struct", func(r *validationRequest) { r.Address = validationAddress{} }, map[string]string{"address": "required;", "address.zip": "required;"}},
+		{"nil pointer", func(r *validationRequest) { r.Submitted = nil }, map[string]string{"submitted": "required;"}},
+		{"pointer to zero value", func(r *validationRequest) { r.Quantity = new(int) }, map[string]string{"quantity": "min;"}},
+		{"string above max", func(r *validationRequest) { r.Address.Zip = "123456" }, map[string]string{"address.zip": "max;"}},
+		{"not numeric", func(r *validationRequest) { r.Address.Zip = "1234a" }, map[string]string{"address.zip": "numeric;"}},
+		{"not one of", func(r *validationRequest) { r.Address.Country = "MX" }, map[string]string{"address.country": "oneOf;"}},
+		{"not an email", func(r *validationRequest) { r.Email = "John <john@example.com>" }, map[string]string{"email": "email;"}},
+		{"email", func(r *validationRequest) { r.Email = "john@example.com" }, map[string]string{}},
+		{"embedded", func(r *validationRequest) { r.RefId = "123456789012345678901" }, map[string]string{"refId": "max;"}},
+		{"optional pointer", func(r *validationRequest) { r.ShipTo = &validationAddress{Zip: "x"} }, map[string]string{"shipTo.zip": "numeric;"}},
+		{"slice elements", func(r *validationRequest) { r.Tags = []string{"a", "c"} }, map[string]string{"tag[1]": "oneOf;"}},
+		{"validator", func(r *validationRequest) { r.Children = []validationChild{{Ok: true}, {}} }, map[string]string{"child[1]": ";;"}},
+		{"pointer to slice", func(r *validationRequest) { r.Nested = &[]validationChild{{}} }, map[string]string{"nested[0]": ";;"}},
+		{"skipped fields", func(r *validationRequest) { r.Skipped = validationAddress{Zip: "x"}; r.ignored = "" }, map[string]string{}},
+	}
+	for _, c := range cases {
+		request := validRequest()
+		c.modify(&request)
+		if failures := fieldErrors(t, Validate(request)); !reflect.DeepEqual(failures, c.failures) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.failures, failures)
+		}
+	}
+}
+
+func TestValidatePointerRequest(t *testing.T) {
+	request := validRequest()
+	request.Count = 0
+	if failures := fieldErrors(t, Validate(&request)); !reflect.DeepEqual(failures, map[string]string{"count": "required;"}) {
+		t.Errorf("unexpected failures %v", failures)
+	}
+	if err := Validate(nil); err != nil {
+		t.Errorf("expected nil to be valid, got %v", err)
+	}
+}
+
+func TestValidationErrorUnwrap(t *testing.T) {
+	request := validRequest()
+	request.Count, request.Address.Zip = 0, ""
+	err := Validate(request)
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Field != "count" {
+		t.Errorf("expected the first FieldError to be count, got %v", fieldErr)
+	}
+	if err.Error() != "count: is required\naddress: is required\naddress.zip: is required" {
+		t.Errorf("unexpected message %q", err.Error())
+	}
+}
+
+func TestIsEmpty(t *testing.T) {
+	var nilPointer *int
+	var nilInterface any
+	cases := []struct {
+		value any
+		empty bool
+	}{
+		{"", true},
+		{"x", false},
+		{0, true},
+		{7, false},
+		{0.0, true},
+		{Amount{}, true},
+		{AmountFromCents(1), false},
+		{validationAddress{}, true},
+		{validationAddress{Zip: "1"}, false},
+		{[]int{}, true},
+		{map[string]int{"a": 1}, false},
+		{nilPointer, true},
+		{new(int), false},
+	}
+	for _, c := range cases {
+		if empty := isEmpty(reflect.ValueOf(c.value)); empty != c.empty {
+			t.Errorf("%#v: expected empty %t, got %t", c.value, c.empty, empty)
+		}
+	}
+	if !isEmpty(reflect.ValueOf(&nilInterface).Elem()) {
+		t.Errorf("expected a nil interface to be empty")
+	}
+}
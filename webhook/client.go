@@ -0,0 +1,300 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	authnet "github.com/BigBallard/gogo-authnet"
+)
+
+const (
+	// MaxNotificationPageSize is the largest page of notification history the API returns.
+	MaxNotificationPageSize = 1000
+	// DefaultClientTimeout bounds each request of a Client created without WithHTTPClient, including reading the
+	// response.
+	DefaultClientTimeout = 30 * time.Second
+)
+
+type WebhookStatusEnum = string
+
+const (
+	WebhookStatusActive   WebhookStatusEnum = "active"
+	WebhookStatusInactive                   = "inactive"
+)
+
+type DeliveryStatusEnum = string
+
+const (
+	DeliveryStatusDelivered    DeliveryStatusEnum = "Delivered"
+	DeliveryStatusRetryPending                    = "RetryPending"
+	DeliveryStatusFailed                          = "Failed"
+)
+
+type EventType struct {
+	Name EventTypeEnum `json:"name"`
+}
+
+// Webhook is an endpoint registered to receive notifications of the listed event types.
+type Webhook struct {
+	WebhookId  string            `json:"webhookId,omitempty"`
+	Name       string            `json:"name,omitempty"`
+	Status     WebhookStatusEnum `json:"status,omitempty"`
+	Url        string            `json:"url"`
+	EventTypes []EventTypeEnum   `json:"eventTypes"`
+}
+
+// NotificationSummary is an entry of the notification history. It does not include the payload, see GetNotification.
+type NotificationSummary struct {
+	NotificationId string             `json:"notificationId"`
+	DeliveryStatus DeliveryStatusEnum `json:"deliveryStatus"`
+	EventType      EventTypeEnum      `json:"eventType"`
+	EventDate      authnet.DateTime   `json:"eventDate"`
+	WebhookId      string             `json:"webhookId"`
+}
+
+// HistoricalNotification is a notification of the history along with its delivery status.
+type HistoricalNotification struct {
+	Notification
+	DeliveryStatus DeliveryStatusEnum `json:"deliveryStatus"`
+}
+
+// APIError is returned when the webhooks API responds with a non 2xx HTTP status.
+type APIError struct {
+	Status        int    `json:"status"`
+	Reason        string `json:"reason"`
+	Message       string `json:"message"`
+	CorrelationId string `json:"correlationId"`
+}
+
+func (e *APIError) Error() string {
+	if len(e.Message) == 0 {
+		return fmt.Sprintf("webhooks api responded with status %d", e.Status)
+	}
+	return fmt.Sprintf("webhooks api responded with status %d: %s", e.Status, e.Message)
+}
+
+// Client manages webhooks and reads the notification history through the webhooks REST API. It authenticates with the
+// API login id and transaction key of the configuration.
+type Client struct {
+	config     authnet.Config
+	baseUrl    string
+	httpClient *http.Client
+}
+
+// ClientOption configures a Client.
+type ClientOption func(c *Client)
+
+// WithHTTPClient sets the http.Client used for the requests, for example to share a transport or to change the
+// timeout.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// NewClient creates a Client for the REST API of config.AuthnetHost. Requests time out after DefaultClientTimeout
+// unless another http.Client is set with WithHTTPClient.
+func NewClient(config authnet.Config, opts ...ClientOption) *Client {
+	c := &Client{
+		config:     config,
+		baseUrl:    strings.TrimSuffix(config.AuthnetHost, "/") + "/rest/v1/",
+		httpClient: &http.Client{Timeout: DefaultClientTimeout},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// ListEventTypes returns every event type a webhook can subscribe to.
+func (c *Client) ListEventTypes() ([]EventType, error) {
+	return c.ListEventTypesContext(context.Background())
+}
+
+// ListEventTypesContext is like ListEventTypes but uses ctx for the request.
+func (c *Client) ListEventTypesContext(ctx context.Context) ([]EventType, error) {
+	var eventTypes []EventType
+	if err := c.do(ctx, http.MethodGet, "eventtypes", nil, &eventTypes); err != nil {
+		return nil, err
+	}
+	return eventTypes, nil
+}
+
+// ListWebhooks returns every webhook of the merchant account.
+func (c *Client) ListWebhooks() ([]Webhook, error) {
+	return c.ListWebhooksContext(context.Background())
+}
+
+// ListWebhooksContext is like ListWebhooks but uses ctx for the request.
+func (c *Client) ListWebhooksContext(ctx context.Context) ([]Webhook, error) {
+	var webhooks []Webhook
+	if err := c.do(ctx, http.MethodGet, "webhooks", nil, &webhooks); err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// GetWebhook returns the webhook with the id.
+func (c *Client) GetWebhook(webhookId string) (*Webhook, error) {
+	return c.GetWebhookContext(context.Background(), webhookId)
+}
+
+// GetWebhookContext is like GetWebhook but uses ctx for the request.
+func (c *Client) GetWebhookContext(ctx context.Context, webhookId string) (*Webhook, error) {
+	var webhook Webhook
+	if err := c.do(ctx, http.MethodGet, "webhooks/"+url.PathEscape(webhookId), nil, &webhook); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// CreateWebhook registers webhook. The gateway sends a test notification to the url first and refuses to create the
+// webhook unless it is acknowledged with 200. WebhookId is ignored.
+func (c *Client) CreateWebhook(webhook Webhook) (*Webhook, error) {
+	return c.CreateWebhookContext(context.Background(), webhook)
+}
+
+// CreateWebhookContext is like CreateWebhook but uses ctx for the request.
+func (c *Client) CreateWebhookContext(ctx context.Context, webhook Webhook) (*Webhook, error) {
+	webhook.WebhookId = ""
+	var created Webhook
+	if err := c.do(ctx, http.MethodPost, "webhooks", webhook, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateWebhook replaces the url, event types and status of the webhook identified by webhook.WebhookId.
+func (c *Client) UpdateWebhook(webhook Webhook) (*Webhook, error) {
+	return c.UpdateWebhookContext(context.Background(), webhook)
+}
+
+// UpdateWebhookContext is like UpdateWebhook but uses ctx for the request.
+func (c *Client) UpdateWebhookContext(ctx context.Context, webhook Webhook) (*Webhook, error) {
+	if len(webhook.WebhookId) == 0 {
+		return nil, errors.New("webhook id is required")
+	}
+	var updated Webhook
+	if err := c.do(ctx, http.MethodPut, "webhooks/"+url.PathEscape(webhook.WebhookId), webhook, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteWebhook removes the webhook with the id.
+func (c *Client) DeleteWebhook(webhookId string) error {
+	return c.DeleteWebhookContext(context.Background(), webhookId)
+}
+
+// DeleteWebhookContext is like DeleteWebhook but uses ctx for the request.
+func (c *Client) DeleteWebhookContext(ctx context.Context, webhookId string) error {
+	return c.do(ctx, http.MethodDelete, "webhooks/"+url.PathEscape(webhookId), nil, nil)
+}
+
+// PingWebhook asks the gateway to send a test notification to the url of the webhook.
+func (c *Client) PingWebhook(webhookId string) error {
+	return c.PingWebhookContext(context.Background(), webhookId)
+}
+
+// PingWebhookContext is like PingWebhook but uses ctx for the request.
+func (c *Client) PingWebhookContext(ctx context.Context, webhookId string) error {
+	return c.do(ctx, http.MethodPost, "webhooks/"+url.PathEscape(webhookId)+"/pings", nil, nil)
+}
+
+// ListNotifications returns a page of the notification history starting at offset. limit is capped at
+// MaxNotificationPageSize.
+func (c *Client) ListNotifications(offset int, limit int) ([]NotificationSummary, error) {
+	return c.ListNotificationsContext(context.Background(), offset, limit)
+}
+
+// ListNotificationsContext is like ListNotifications but uses ctx for the request.
+func (c *Client) ListNotificationsContext(ctx context.Context, offset int, limit int) ([]NotificationSummary, error) {
+	if limit <= 0 || limit > MaxNotificationPageSize {
+		limit = MaxNotificationPageSize
+	}
+	query := url.Values{}
+	query.Set("offset", strconv.Itoa(offset))
+	query.Set("limit", strconv.Itoa(limit))
+	var history struct {
+		Notifications []NotificationSummary `json:"notifications"`
+	}
+	if err := c.do(ctx, http.MethodGet, "notifications?"+query.Encode(), nil, &history); err != nil {
+		return nil, err
+	}
+	return history.Notifications, nil
+}
+
+// GetNotification returns a notification of the history including its payload.
+func (c *Client) GetNotification(notificationId string) (*HistoricalNotification, error) {
+	return c.GetNotificationContext(context.Background(), notificationId)
+}
+
+// GetNotificationContext is like GetNotification but uses ctx for the request.
+func (c *Client) GetNotificationContext(ctx context.Context, notificationId string) (*HistoricalNotification, error) {
+	var notification HistoricalNotification
+	if err := c.do(ctx, http.MethodGet, "notifications/"+url.PathEscape(notificationId), nil, &notification); err != nil {
+		return nil, err
+	}
+	return &notification, nil
+}
+
+// do sends body, if any, as JSON to the path relative to the base url and decodes the response into res, if any.
+func (c *Client) do(ctx context.Context, method string, path string, body any, res any) error {
+	var reader io.Reader
+	if body != nil {
+		bodyBytes, mErr := json.Marshal(body)
+		if mErr != nil {
+			return errors.Join(errors.New("unable to marshal request body"), mErr)
+		}
+		reader = bytes.NewReader(bodyBytes)
+	}
+	httpRequest, nrErr := http.NewRequestWithContext(ctx, method, c.baseUrl+path, reader)
+	if nrErr != nil {
+		return errors.Join(errors.New("unable to create http request"), nrErr)
+	}
+	if body != nil {
+		httpRequest.Header.Set("Content-Type", "application/json")
+	}
+	httpRequest.Header.Set("Accept", "application/json")
+	if c.config.Auth != nil {
+		httpRequest.SetBasicAuth(c.config.Auth.ApiLoginId, c.config.Auth.TransactionKey)
+	}
+	response, reqErr := c.httpClient.Do(httpRequest)
+	if reqErr != nil {
+		return &authnet.TransportError{Err: errors.Join(errors.New("unable to make http request"), reqErr)}
+	}
+	defer response.Body.Close()
+	maxSize := c.config.MaxResponseBodySize
+	if maxSize <= 0 {
+		maxSize = authnet.DefaultMaxResponseBodySize
+	}
+	resBytes, readErr := io.ReadAll(io.LimitReader(response.Body, maxSize+1))
+	if readErr != nil {
+		return &authnet.TransportError{Err: errors.Join(errors.New("unable to read response body"), readErr)}
+	}
+	if int64(len(resBytes)) > maxSize {
+		return &authnet.TransportError{Err: fmt.Errorf("response body exceeds the maximum size of %d bytes", maxSize)}
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		apiErr := APIError{Status: response.StatusCode}
+		_ = json.Unmarshal(resBytes, &apiErr)
+		apiErr.Status = response.StatusCode
+		return &apiErr
+	}
+	if res == nil || len(bytes.TrimSpace(resBytes)) == 0 {
+		return nil
+	}
+	if uErr := json.Unmarshal(resBytes, res); uErr != nil {
+		return errors.Join(errors.New("unable to unmarshal response body"), uErr)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	authnet "github.com/BigBallard/gogo-authnet"
+)
+
+func TestClientCreateWebhook(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		login, key, ok := r.BasicAuth()
+		if !ok || login != "login" || key != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"status":401,"reason":"UNAUTHORIZED","message":"Invalid credentials."}`))
+			return
+		}
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/v1/webhooks" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var webhook Webhook
+		if err := json.NewDecoder(r.Body).Decode(&webhook); err != nil {
+			t.Error(err)
+		}
+		webhook.WebhookId = "72a55c78-66e6-4b1e-a4d6-3f925c00561f"
+		json.NewEncoder(w).Encode(webhook)
+	}))
+	defer srv.Close()
+
+	client := NewClient(authnet.Config{AuthnetHost: srv.URL + "/", Auth: &authnet.Auth{ApiLoginId: "login", TransactionKey: "key"}})
+	created, err := client.CreateWebhook(Webhook{
+		Name:       "orders",
+		Url:        "https://example.com/authnet/webhook",
+		EventTypes: []EventTypeEnum{EventTypePaymentAuthCaptureCreated},
+		Status:     WebhookStatusActive,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.WebhookId != "72a55c78-66e6-4b1e-a4d6-3f925c00561f" || created.Url != "https://example.com/authnet/webhook" {
+		t.Fatalf("unexpected webhook %+v", created)
+	}
+
+	unauthorized := NewClient(authnet.Config{AuthnetHost: srv.URL, Auth: &authnet.Auth{ApiLoginId: "login", TransactionKey: "wrong"}})
+	_, err = unauthorized.ListWebhooks()
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Status != http.StatusUnauthorized || apiErr.Reason != "UNAUTHORIZED" {
+		t.Fatalf("expected an unauthorized APIError, got %v", err)
+	}
+}
+
+func TestClientReadsNotificationHistory(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/v1/notifications":
+			if r.URL.Query().Get("offset") != "0" || r.URL.Query().Get("limit") != "1000" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"_links":{"self":{"href":"/rest/v1/notifications?offset=0&limit=1000"}},"notifications":[` +
+				`{"_links":{"self":{"href":"/rest/v1/notifications/e35d5ede-27c5-46cc-aabb-131f1df1bb8a"}},` +
+				`"notificationId":"e35d5ede-27c5-46cc-aabb-131f1df1bb8a","deliveryStatus":"Delivered",` +
+				`"eventType":"net.authorize.payment.authcapture.created","eventDate":"2017-04-04T16:14:16.7488414",` +
+				`"webhookId":"b9d3b9f9-a4da-4b8f-9d3e-9a36e1cf4b3e"}]}`))
+		case "/rest/v1/notifications/e35d5ede-27c5-46cc-aabb-131f1df1bb8a":
+			w.Write([]byte(`{"notificationId":"e35d5ede-27c5-46cc-aabb-131f1df1bb8a","deliveryStatus":"Delivered",` +
+				`"eventType":"net.authorize.payment.authcapture.created","eventDate":"2017-04-04T16:14:16.7488414",` +
+				`"webhookId":"b9d3b9f9-a4da-4b8f-9d3e-9a36e1cf4b3e",` +
+				`"payload":{"responseCode":1,"authCode":"LZ6I19","avsResponse":"Y","authAmount":45.00,"entityName":"transaction","id":"60020981676"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	client := NewClient(authnet.Config{AuthnetHost: srv.URL})
+	expected := time.Date(2017, 4, 4, 16, 14, 16, 748841400, time.UTC)
+
+	history, listErr := client.ListNotifications(0, 0)
+	if listErr != nil {
+		t.Fatal(listErr)
+	}
+	if len(history) != 1 || !history[0].EventDate.Equal(expected) || history[0].DeliveryStatus != DeliveryStatusDelivered {
+		t.Fatalf("unexpected history %+v", history)
+	}
+
+	notification, getErr := client.GetNotification(history[0].NotificationId)
+	if getErr != nil {
+		t.Fatal(getErr)
+	}
+	if !notification.EventDate.Equal(expected) || len(notification.Payload) == 0 {
+		t.Fatalf("unexpected notification %+v", notification)
+	}
+}
+
+type recordingTransport struct {
+	requests []string
+}
+
+func (t *recordingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, r.Method+" "+r.URL.Path)
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestClientHTTPClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"name":"net.authorize.payment.void.created"}]`))
+	}))
+	defer srv.Close()
+	config := authnet.Config{AuthnetHost: srv.URL}
+
+	if timeout := NewClient(config).httpClient.Timeout; timeout != DefaultClientTimeout {
+		t.Errorf("expected the default client to time out after %s, got %s", DefaultClientTimeout, timeout)
+	}
+
+	transport := &recordingTransport{}
+	client := NewClient(config, WithHTTPClient(&http.Client{Transport: transport}))
+	eventTypes, err := client.ListEventTypes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(eventTypes) != 1 || eventTypes[0].Name != EventTypePaymentVoidCreated {
+		t.Errorf("unexpected event types %+v", eventTypes)
+	}
+	if len(transport.requests) != 1 || transport.requests[0] != "GET /rest/v1/eventtypes" {
+		t.Errorf("expected the request to use the given client, got %v", transport.requests)
+	}
+}
@@ -0,0 +1,172 @@
+// Package webhook receives Authorize.net webhook notifications. A Handler verifies the signature of each delivery,
+// decodes the notification into a typed event and dispatches it to the registered callbacks.
+package webhook
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	authnet "github.com/BigBallard/gogo-authnet"
+)
+
+type EventTypeEnum = string
+
+const (
+	EventTypePaymentAuthCaptureCreated      EventTypeEnum = "net.authorize.payment.authcapture.created"
+	EventTypePaymentAuthorizationCreated                  = "net.authorize.payment.authorization.created"
+	EventTypePaymentCaptureCreated                        = "net.authorize.payment.capture.created"
+	EventTypePaymentFraudApproved                         = "net.authorize.payment.fraud.approved"
+	EventTypePaymentFraudDeclined                         = "net.authorize.payment.fraud.declined"
+	EventTypePaymentFraudHeld                             = "net.authorize.payment.fraud.held"
+	EventTypePaymentPriorAuthCaptureCreated               = "net.authorize.payment.priorAuthCapture.created"
+	EventTypePaymentRefundCreated                         = "net.authorize.payment.refund.created"
+	EventTypePaymentVoidCreated                           = "net.authorize.payment.void.created"
+	EventTypeCustomerCreated                              = "net.authorize.customer.created"
+	EventTypeCustomerDeleted                              = "net.authorize.customer.deleted"
+	EventTypeCustomerUpdated                              = "net.authorize.customer.updated"
+	EventTypeCustomerPaymentProfileCreated                = "net.authorize.customer.paymentProfile.created"
+	EventTypeCustomerPaymentProfileDeleted                = "net.authorize.customer.paymentProfile.deleted"
+	EventTypeCustomerPaymentProfileUpdated                = "net.authorize.customer.paymentProfile.updated"
+	EventTypeCustomerSubscriptionCancelled                = "net.authorize.customer.subscription.cancelled"
+	EventTypeCustomerSubscriptionCreated                  = "net.authorize.customer.subscription.created"
+	EventTypeCustomerSubscriptionExpired                  = "net.authorize.customer.subscription.expired"
+	EventTypeCustomerSubscriptionExpiring                 = "net.authorize.customer.subscription.expiring"
+	EventTypeCustomerSubscriptionFailed                   = "net.authorize.customer.subscription.failed"
+	EventTypeCustomerSubscriptionSuspended                = "net.authorize.customer.subscription.suspended"
+	EventTypeCustomerSubscriptionTerminated               = "net.authorize.customer.subscription.terminated"
+	EventTypeCustomerSubscriptionUpdated                  = "net.authorize.customer.subscription.updated"
+)
+
+const (
+	paymentEventPrefix        = "net.authorize.payment."
+	customerEventPrefix       = "net.authorize.customer."
+	paymentProfileEventPrefix = "net.authorize.customer.paymentProfile."
+	subscriptionEventPrefix   = "net.authorize.customer.subscription."
+)
+
+// Notification is the envelope of every webhook delivery. Payload holds the event specific JSON object.
+type Notification struct {
+	NotificationId string           `json:"notificationId"`
+	EventType      EventTypeEnum    `json:"eventType"`
+	EventDate      authnet.DateTime `json:"eventDate"`
+	WebhookId      string           `json:"webhookId"`
+	Payload        json.RawMessage  `json:"payload"`
+}
+
+// ID is an id the gateway sends as either a JSON string or a number.
+type ID string
+
+func (id *ID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*id = ID(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*id = ID(n.String())
+	return nil
+}
+
+type FraudFilter struct {
+	FraudFilter string `json:"fraudFilter"`
+	FraudAction string `json:"fraudAction"`
+}
+
+// PaymentPayload is the payload of the net.authorize.payment events. Id is the transaction id.
+type PaymentPayload struct {
+	ResponseCode        int            `json:"responseCode"`
+	AuthCode            string         `json:"authCode,omitempty"`
+	AVSResponse         string         `json:"avsResponse,omitempty"`
+	AuthAmount          authnet.Amount `json:"authAmount"`
+	InvoiceNumber       string         `json:"invoiceNumber,omitempty"`
+	MerchantReferenceId string         `json:"merchantReferenceId,omitempty"`
+	FraudList           []FraudFilter  `json:"fraudList,omitempty"`
+	EntityName          string         `json:"entityName"`
+	Id                  ID             `json:"id"`
+}
+
+type PaymentEvent struct {
+	Notification
+	Payload PaymentPayload
+}
+
+// Outcome returns the outcome of the transaction from the responseCode of the payload. Refund and void events carry
+// the outcome of the refund or void, not of the original transaction.
+func (e *PaymentEvent) Outcome() authnet.TransactionOutcomeEnum {
+	return authnet.TransactionOutcome(strconv.Itoa(e.Payload.ResponseCode))
+}
+
+type PaymentProfileRef struct {
+	Id           ID     `json:"id"`
+	CustomerType string `json:"customerType,omitempty"`
+}
+
+// CustomerPayload is the payload of the net.authorize.customer events. Id is the customer profile id.
+type CustomerPayload struct {
+	MerchantCustomerId string              `json:"merchantCustomerId,omitempty"`
+	Description        string              `json:"description,omitempty"`
+	PaymentProfiles    []PaymentProfileRef `json:"paymentProfiles,omitempty"`
+	EntityName         string              `json:"entityName"`
+	Id                 ID                  `json:"id"`
+}
+
+type CustomerEvent struct {
+	Notification
+	Payload CustomerPayload
+}
+
+// PaymentProfilePayload is the payload of the net.authorize.customer.paymentProfile events. Id is the customer payment
+// profile id.
+type PaymentProfilePayload struct {
+	CustomerProfileId ID     `json:"customerProfileId"`
+	CustomerType      string `json:"customerType,omitempty"`
+	EntityName        string `json:"entityName"`
+	Id                ID     `json:"id"`
+}
+
+type PaymentProfileEvent struct {
+	Notification
+	Payload PaymentProfilePayload
+}
+
+type SubscriptionProfile struct {
+	CustomerProfileId         ID `json:"customerProfileId"`
+	CustomerPaymentProfileId  ID `json:"customerPaymentProfileId"`
+	CustomerShippingAddressId ID `json:"customerShippingAddressId,omitempty"`
+}
+
+// SubscriptionPayload is the payload of the net.authorize.customer.subscription events. Id is the subscription id.
+type SubscriptionPayload struct {
+	Name       string                            `json:"name,omitempty"`
+	Amount     authnet.Amount                    `json:"amount"`
+	Status     authnet.ARBSubscriptionStatusEnum `json:"status,omitempty"`
+	Profile    *SubscriptionProfile              `json:"profile,omitempty"`
+	EntityName string                            `json:"entityName"`
+	Id         ID                                `json:"id"`
+}
+
+type SubscriptionEvent struct {
+	Notification
+	Payload SubscriptionPayload
+}
+
+// family returns the prefix of the event family the event type belongs to, checking the more specific customer
+// families first.
+func family(eventType EventTypeEnum) string {
+	for _, prefix := range []string{paymentEventPrefix, subscriptionEventPrefix, paymentProfileEventPrefix, customerEventPrefix} {
+		if strings.HasPrefix(eventType, prefix) {
+			return prefix
+		}
+	}
+	return ""
+}
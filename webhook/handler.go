@@ -0,0 +1,322 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader carries the HMAC-SHA512 of the request body, hex encoded and prefixed with sha512=.
+	SignatureHeader = "X-ANET-Signature"
+	// DefaultMaxAge is how old a notification may be before it is rejected as stale. The gateway keeps retrying failed
+	// deliveries for several days, so this is generous; use WithStore to skip repeated deliveries.
+	DefaultMaxAge = 5 * 24 * time.Hour
+	// DefaultMaxBodySize is the largest request body read. Notifications are far smaller.
+	DefaultMaxBodySize = 1 << 20
+	// maxClockSkew is how far in the future the event date of a notification may be.
+	maxClockSkew = 5 * time.Minute
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrMalformed        = errors.New("malformed webhook notification")
+	ErrStale            = errors.New("stale webhook notification")
+)
+
+// Handler is an http.Handler that receives webhook notifications. Deliveries with a missing or invalid signature are
+// answered with 401, malformed or stale notifications with 400 and notifications whose callback failed with 500, so
+// that the gateway delivers them again. Every other notification, including event types without a callback, is
+// acknowledged with 200.
+type Handler struct {
+	signatureKey     []byte
+	maxAge           time.Duration
+	maxBodySize      int64
+	now              func() time.Time
+	onPayment        func(ctx context.Context, event *PaymentEvent) error
+	onCustomer       func(ctx context.Context, event *CustomerEvent) error
+	onPaymentProfile func(ctx context.Context, event *PaymentProfileEvent) error
+	onSubscription   func(ctx context.Context, event *SubscriptionEvent) error
+	onNotification   func(ctx context.Context, notification *Notification) error
+	onError          func(r *http.Request, err error)
+	store            Store
+}
+
+// Option configures a Handler.
+type Option func(h *Handler)
+
+// WithMaxAge sets how old a notification may be before it is rejected. Zero disables the check.
+func WithMaxAge(maxAge time.Duration) Option {
+	return func(h *Handler) {
+		h.maxAge = maxAge
+	}
+}
+
+// WithMaxBodySize sets the largest request body read.
+func WithMaxBodySize(size int64) Option {
+	return func(h *Handler) {
+		h.maxBodySize = size
+	}
+}
+
+// WithClock sets the function used to check the age of notifications.
+func WithClock(now func() time.Time) Option {
+	return func(h *Handler) {
+		h.now = now
+	}
+}
+
+// WithStore deduplicates notifications by their notificationId with store, so that each is passed to the callbacks
+// once. A notification whose callback failed is removed from store again, to be processed when it is delivered again.
+func WithStore(store Store) Option {
+	return func(h *Handler) {
+		h.store = store
+	}
+}
+
+// OnPayment registers the callback for the net.authorize.payment events.
+func OnPayment(callback func(ctx context.Context, event *PaymentEvent) error) Option {
+	return func(h *Handler) {
+		h.onPayment = callback
+	}
+}
+
+// OnCustomer registers the callback for the net.authorize.customer.created, updated and deleted events.
+func OnCustomer(callback func(ctx context.Context, event *CustomerEvent) error) Option {
+	return func(h *Handler) {
+		h.onCustomer = callback
+	}
+}
+
+// OnPaymentProfile registers the callback for the net.authorize.customer.paymentProfile events.
+func OnPaymentProfile(callback func(ctx context.Context, event *PaymentProfileEvent) error) Option {
+	return func(h *Handler) {
+		h.onPaymentProfile = callback
+	}
+}
+
+// OnSubscription registers the callback for the net.authorize.customer.subscription events.
+func OnSubscription(callback func(ctx context.Context, event *SubscriptionEvent) error) Option {
+	return func(h *Handler) {
+		h.onSubscription = callback
+	}
+}
+
+// OnNotification registers the callback for notifications of event families without a registered callback, including
+// event types this package does not know.
+func OnNotification(callback func(ctx context.Context, notification *Notification) error) Option {
+	return func(h *Handler) {
+		h.onNotification = callback
+	}
+}
+
+// OnError registers a callback for rejected deliveries and failed callbacks, for example to log them.
+func OnError(callback func(r *http.Request, err error)) Option {
+	return func(h *Handler) {
+		h.onError = callback
+	}
+}
+
+// NewHandler creates a Handler verifying notifications with the signature key of the merchant account, found in the
+// Merchant Interface under API Credentials and Keys.
+func NewHandler(signatureKey string, opts ...Option) *Handler {
+	h := &Handler{
+		signatureKey: []byte(signatureKey),
+		maxAge:       DefaultMaxAge,
+		maxBodySize:  DefaultMaxBodySize,
+		now:          time.Now,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		h.reject(w, r, http.StatusMethodNotAllowed, fmt.Errorf("unexpected method %s", r.Method))
+		return
+	}
+	body, readErr := io.ReadAll(io.LimitReader(r.Body, h.maxBodySize+1))
+	if readErr != nil {
+		h.reject(w, r, http.StatusBadRequest, errors.Join(errors.New("unable to read request body"), readErr))
+		return
+	}
+	if int64(len(body)) > h.maxBodySize {
+		h.reject(w, r, http.StatusRequestEntityTooLarge, fmt.Errorf("%w: body exceeds %d bytes", ErrMalformed, h.maxBodySize))
+		return
+	}
+	if vErr := VerifySignature(string(h.signatureKey), r.Header.Get(SignatureHeader), body); vErr != nil {
+		h.reject(w, r, http.StatusUnauthorized, vErr)
+		return
+	}
+	notification, pErr := ParseNotification(body)
+	if pErr != nil {
+		h.reject(w, r, http.StatusBadRequest, pErr)
+		return
+	}
+	if h.maxAge > 0 {
+		now := h.now()
+		if notification.EventDate.Before(now.Add(-h.maxAge)) || notification.EventDate.After(now.Add(maxClockSkew)) {
+			h.reject(w, r, http.StatusBadRequest, fmt.Errorf("%w: notification %s dated %s", ErrStale,
+				notification.NotificationId, notification.EventDate.Format(time.RFC3339)))
+			return
+		}
+	}
+	if dErr := h.Dispatch(r.Context(), notification); dErr != nil {
+		h.reject(w, r, http.StatusInternalServerError, dErr)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) reject(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if h.onError != nil {
+		h.onError(r, err)
+	}
+	http.Error(w, http.StatusText(status), status)
+}
+
+// Dispatch decodes the payload of a notification that has already been verified and passes the event to the callback
+// registered for its family. It is used by ServeHTTP and can feed notifications obtained elsewhere, such as the
+// notification history, through the same callbacks. With WithStore, notifications that have already been processed are
+// skipped.
+func (h *Handler) Dispatch(ctx context.Context, notification *Notification) error {
+	_, err := h.dispatchOnce(ctx, notification)
+	return err
+}
+
+// dispatchOnce is Dispatch, additionally reporting whether the notification was passed to the callbacks rather than
+// skipped by the store.
+func (h *Handler) dispatchOnce(ctx context.Context, notification *Notification) (bool, error) {
+	if h.store == nil {
+		return true, h.dispatch(ctx, notification)
+	}
+	added, addErr := h.store.Add(ctx, notification.NotificationId)
+	if addErr != nil {
+		return false, errors.Join(fmt.Errorf("unable to record notification %s", notification.NotificationId), addErr)
+	}
+	if !added {
+		return false, nil
+	}
+	if dErr := h.dispatch(ctx, notification); dErr != nil {
+		if rErr := h.store.Remove(ctx, notification.NotificationId); rErr != nil {
+			return true, errors.Join(dErr, rErr)
+		}
+		return true, dErr
+	}
+	return true, nil
+}
+
+func (h *Handler) dispatch(ctx context.Context, notification *Notification) error {
+	switch family(notification.EventType) {
+	case paymentEventPrefix:
+		if h.onPayment != nil {
+			event := PaymentEvent{Notification: *notification}
+			if err := decodePayload(notification, &event.Payload); err != nil {
+				return err
+			}
+			return h.onPayment(ctx, &event)
+		}
+	case subscriptionEventPrefix:
+		if h.onSubscription != nil {
+			event := SubscriptionEvent{Notification: *notification}
+			if err := decodePayload(notification, &event.Payload); err != nil {
+				return err
+			}
+			return h.onSubscription(ctx, &event)
+		}
+	case paymentProfileEventPrefix:
+		if h.onPaymentProfile != nil {
+			event := PaymentProfileEvent{Notification: *notification}
+			if err := decodePayload(notification, &event.Payload); err != nil {
+				return err
+			}
+			return h.onPaymentProfile(ctx, &event)
+		}
+	case customerEventPrefix:
+		if h.onCustomer != nil {
+			event := CustomerEvent{Notification: *notification}
+			if err := decodePayload(notification, &event.Payload); err != nil {
+				return err
+			}
+			return h.onCustomer(ctx, &event)
+		}
+	}
+	if h.onNotification != nil {
+		return h.onNotification(ctx, notification)
+	}
+	return nil
+}
+
+func decodePayload(notification *Notification, payload any) error {
+	if err := json.Unmarshal(notification.Payload, payload); err != nil {
+		return fmt.Errorf("%w: unable to decode payload of notification %s: %s", ErrMalformed,
+			notification.NotificationId, err.Error())
+	}
+	return nil
+}
+
+// ParseNotification decodes the notification envelope of a request body. The notificationId, eventType, eventDate and
+// payload fields are required.
+func ParseNotification(body []byte) (*Notification, error) {
+	var notification Notification
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	if err := decoder.Decode(&notification); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMalformed, err.Error())
+	}
+	var missing []string
+	if len(notification.NotificationId) == 0 {
+		missing = append(missing, "notificationId")
+	}
+	if len(notification.EventType) == 0 {
+		missing = append(missing, "eventType")
+	}
+	if notification.EventDate.IsZero() {
+		missing = append(missing, "eventDate")
+	}
+	if len(notification.Payload) == 0 || string(notification.Payload) == "null" {
+		missing = append(missing, "payload")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: missing %s", ErrMalformed, strings.Join(missing, ", "))
+	}
+	return &notification, nil
+}
+
+// VerifySignature checks the X-ANET-Signature header value against the HMAC-SHA512 of body keyed with the signature
+// key. The hex digest is compared case-insensitively, as the gateway sends it in upper case.
+func VerifySignature(signatureKey string, header string, body []byte) error {
+	algorithm, digest, found := strings.Cut(strings.TrimSpace(header), "=")
+	if !found || !strings.EqualFold(algorithm, "sha512") {
+		return fmt.Errorf("%w: expected a sha512 signature", ErrInvalidSignature)
+	}
+	signature, decodeErr := hex.DecodeString(strings.ToLower(digest))
+	if decodeErr != nil {
+		return fmt.Errorf("%w: signature is not hex encoded", ErrInvalidSignature)
+	}
+	mac := hmac.New(sha512.New, []byte(signatureKey))
+	mac.Write(body)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Sign returns the X-ANET-Signature header value for body, as the gateway computes it. It is useful for testing
+// handlers.
+func Sign(signatureKey string, body []byte) string {
+	mac := hmac.New(sha512.New, []byte(signatureKey))
+	mac.Write(body)
+	return "sha512=" + strings.ToUpper(hex.EncodeToString(mac.Sum(nil)))
+}
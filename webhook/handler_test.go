@@ -0,0 +1,106 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	authnet "github.com/BigBallard/gogo-authnet"
+)
+
+const testSignatureKey = "4C1B7C7D5E7A36F1B2E3C4D5E6F708192A3B4C5D6E7F80910A1B2C3D4E5F60718293A4B5C6D7E8F90A1B2C3D4E5F607182A3B4C5D6E7F8091A2B3C4D5E6F70"
+
+var testNow = time.Date(2023, 3, 29, 20, 50, 0, 0, time.UTC)
+
+func deliver(h *Handler, body string, signature string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	request.Header.Set(SignatureHeader, signature)
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestHandlerDispatchesTypedEvents(t *testing.T) {
+	var payment *PaymentEvent
+	var subscription *SubscriptionEvent
+	h := NewHandler(testSignatureKey,
+		WithClock(func() time.Time { return testNow }),
+		OnPayment(func(ctx context.Context, event *PaymentEvent) error {
+			payment = event
+			return nil
+		}),
+		OnSubscription(func(ctx context.Context, event *SubscriptionEvent) error {
+			subscription = event
+			return nil
+		}),
+	)
+
+	body := `{"notificationId":"d0e8e7fe-c3e7-4add-a480-27bc5ce28a18","eventType":"net.authorize.payment.authcapture.created",` +
+		`"eventDate":"2023-03-29T20:48:02.0080095Z","webhookId":"63d6fea2-aa13-4b1d-a204-f5fbc15942b7",` +
+		`"payload":{"responseCode":1,"authCode":"LZ6I19","avsResponse":"Y","authAmount":45.00,"entityName":"transaction","id":"60020981676"}}`
+	if res := deliver(h, body, strings.ToLower(Sign(testSignatureKey, []byte(body)))); res.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", res.Code)
+	}
+	if payment == nil || payment.Payload.Id != "60020981676" || payment.Outcome() != authnet.TransactionOutcomeApproved {
+		t.Fatalf("unexpected payment event %+v", payment)
+	}
+	if payment.Payload.AuthAmount.Cmp(authnet.AmountFromInt(45)) != 0 {
+		t.Fatalf("expected auth amount 45.00, got %s", payment.Payload.AuthAmount)
+	}
+
+	body = `{"notificationId":"5c3f7e00-1265-4e8e-abd0-a7d734163881","eventType":"net.authorize.customer.subscription.suspended",` +
+		`"eventDate":"2023-03-29T20:49:00Z","webhookId":"63d6fea2-aa13-4b1d-a204-f5fbc15942b7",` +
+		`"payload":{"name":"Monthly","amount":23.45,"status":"suspended","profile":{"customerProfileId":394,"customerPaymentProfileId":694},"entityName":"subscription","id":"100188"}}`
+	if res := deliver(h, body, Sign(testSignatureKey, []byte(body))); res.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", res.Code)
+	}
+	if subscription == nil || subscription.Payload.Status != authnet.ARBSubscriptionStatusSuspended ||
+		subscription.Payload.Profile == nil || subscription.Payload.Profile.CustomerProfileId != "394" {
+		t.Fatalf("unexpected subscription event %+v", subscription)
+	}
+}
+
+func TestHandlerRejectsDeliveries(t *testing.T) {
+	var rejected []error
+	failing := errors.New("callback failed")
+	h := NewHandler(testSignatureKey,
+		WithClock(func() time.Time { return testNow }),
+		OnCustomer(func(ctx context.Context, event *CustomerEvent) error {
+			return failing
+		}),
+		OnError(func(r *http.Request, err error) {
+			rejected = append(rejected, err)
+		}),
+	)
+	valid := `{"notificationId":"1","eventType":"net.authorize.customer.created","eventDate":"2023-03-29T20:48:02Z",` +
+		`"webhookId":"2","payload":{"entityName":"customerProfile","id":"394"}}`
+	stale := strings.Replace(valid, "2023-03-29", "2023-03-01", 1)
+	malformed := `{"notificationId":"1","eventType":"net.authorize.customer.created"}`
+
+	cases := []struct {
+		name      string
+		body      string
+		signature string
+		status    int
+		err       error
+	}{
+		{"missing signature", valid, "", http.StatusUnauthorized, ErrInvalidSignature},
+		{"wrong signature", valid, Sign("other", []byte(valid)), http.StatusUnauthorized, ErrInvalidSignature},
+		{"stale", stale, Sign(testSignatureKey, []byte(stale)), http.StatusBadRequest, ErrStale},
+		{"malformed", malformed, Sign(testSignatureKey, []byte(malformed)), http.StatusBadRequest, ErrMalformed},
+		{"callback error", valid, Sign(testSignatureKey, []byte(valid)), http.StatusInternalServerError, failing},
+	}
+	for _, c := range cases {
+		rejected = nil
+		if res := deliver(h, c.body, c.signature); res.Code != c.status {
+			t.Errorf("%s: expected %d, got %d", c.name, c.status, res.Code)
+		}
+		if len(rejected) != 1 || !errors.Is(rejected[0], c.err) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.err, rejected)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Replayer fetches notifications from the notification history and feeds them through a Handler, for example to
+// process the notifications missed while the receiving service was down. Configure the Handler with WithStore, backed
+// by a store that survives restarts such as FileStore, so that notifications that were already processed are skipped.
+type Replayer struct {
+	client  *Client
+	handler *Handler
+}
+
+// NewReplayer creates a Replayer reading the history with client and passing the notifications to handler.
+func NewReplayer(client *Client, handler *Handler) *Replayer {
+	return &Replayer{client: client, handler: handler}
+}
+
+// Replay passes every notification of the history dated at or after since to the Handler, oldest first, and returns
+// how many were dispatched to its callbacks; notifications skipped by the store of the Handler are not counted. When
+// statuses are given only the notifications with one of these delivery statuses are replayed. Replay stops at the
+// first notification that could not be fetched or whose callback failed; replaying again resumes from it when the
+// Handler has a store.
+//
+// The order of the history is not documented, so every page is read rather than stopping at the first notification
+// older than since.
+func (r *Replayer) Replay(ctx context.Context, since time.Time, statuses ...DeliveryStatusEnum) (int, error) {
+	var pending []NotificationSummary
+	for offset := 0; ; offset += MaxNotificationPageSize {
+		page, err := r.client.ListNotificationsContext(ctx, offset, MaxNotificationPageSize)
+		if err != nil {
+			return 0, fmt.Errorf("unable to list notifications at offset %d: %w", offset, err)
+		}
+		for _, summary := range page {
+			if !summary.EventDate.Before(since) && hasDeliveryStatus(summary.DeliveryStatus, statuses) {
+				pending = append(pending, summary)
+			}
+		}
+		if len(page) < MaxNotificationPageSize {
+			break
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].EventDate.Before(pending[j].EventDate.Time)
+	})
+
+	dispatched := 0
+	for _, summary := range pending {
+		notification, getErr := r.client.GetNotificationContext(ctx, summary.NotificationId)
+		if getErr != nil {
+			return dispatched, fmt.Errorf("unable to fetch notification %s: %w", summary.NotificationId, getErr)
+		}
+		if len(notification.NotificationId) == 0 {
+			notification.NotificationId = summary.NotificationId
+		}
+		ok, dErr := r.handler.dispatchOnce(ctx, &notification.Notification)
+		if dErr != nil {
+			return dispatched, fmt.Errorf("unable to process notification %s: %w", summary.NotificationId, dErr)
+		}
+		if ok {
+			dispatched++
+		}
+	}
+	return dispatched, nil
+}
+
+func hasDeliveryStatus(status DeliveryStatusEnum, statuses []DeliveryStatusEnum) bool {
+	if len(statuses) == 0 {
+		return true
+	}
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
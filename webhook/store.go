@@ -0,0 +1,197 @@
+package webhook
+
+import (
+	"bufio"
+	"container/list"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// DefaultStoreCapacity is the number of notification ids kept by a store when no capacity is provided. The gateway
+// retries a delivery for days, so the capacity should cover the notifications received in that time.
+const DefaultStoreCapacity = 100000
+
+// Store records the ids of the notifications that have been processed, so that a Handler configured with WithStore
+// processes every notification once even though the gateway delivers it again when unsure of an earlier delivery.
+type Store interface {
+	// Add records the notification id. It reports false when the id was already recorded, in which case the
+	// notification is not processed again.
+	Add(ctx context.Context, notificationId string) (bool, error)
+	// Remove forgets the notification id after its processing failed, so that a later delivery processes it.
+	Remove(ctx context.Context, notificationId string) error
+}
+
+// MemoryStore is a Store keeping the most recently added notification ids in memory.
+type MemoryStore struct {
+	mutex    sync.Mutex
+	capacity int
+	order    *list.List
+	ids      map[string]*list.Element
+}
+
+// NewMemoryStore creates a MemoryStore of capacity ids, evicting the least recently added id once full. A capacity of
+// zero or less uses DefaultStoreCapacity.
+func NewMemoryStore(capacity int) *MemoryStore {
+	if capacity <= 0 {
+		capacity = DefaultStoreCapacity
+	}
+	return &MemoryStore{
+		capacity: capacity,
+		order:    list.New(),
+		ids:      make(map[string]*list.Element),
+	}
+}
+
+func (s *MemoryStore) Add(ctx context.Context, notificationId string) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.add(notificationId), nil
+}
+
+func (s *MemoryStore) Remove(ctx context.Context, notificationId string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.remove(notificationId)
+	return nil
+}
+
+func (s *MemoryStore) add(notificationId string) bool {
+	if element, ok := s.ids[notificationId]; ok {
+		s.order.MoveToBack(element)
+		return false
+	}
+	s.ids[notificationId] = s.order.PushBack(notificationId)
+	for s.order.Len() > s.capacity {
+		oldest := s.order.Front()
+		s.order.Remove(oldest)
+		delete(s.ids, oldest.Value.(string))
+	}
+	return true
+}
+
+func (s *MemoryStore) remove(notificationId string) {
+	if element, ok := s.ids[notificationId]; ok {
+		s.order.Remove(element)
+		delete(s.ids, notificationId)
+	}
+}
+
+// FileStore is a Store persisting the notification ids to a file, one per line, so that they survive restarts. It keeps
+// the most recently added ids like MemoryStore and rewrites the file once it holds twice the capacity.
+type FileStore struct {
+	memory *MemoryStore
+	path   string
+	file   *os.File
+	lines  int
+}
+
+// OpenFileStore opens or creates the file at path and loads its notification ids. A capacity of zero or less uses
+// DefaultStoreCapacity. The FileStore must be closed after use.
+func OpenFileStore(path string, capacity int) (*FileStore, error) {
+	s := FileStore{memory: NewMemoryStore(capacity), path: path}
+	existing, openErr := os.Open(path)
+	if openErr == nil {
+		scanner := bufio.NewScanner(existing)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if removed, found := strings.CutPrefix(line, "-"); found {
+				s.memory.remove(removed)
+			} else if len(line) > 0 {
+				s.memory.add(line)
+			}
+			s.lines++
+		}
+		scanErr := scanner.Err()
+		existing.Close()
+		if scanErr != nil {
+			return nil, errors.Join(errors.New("unable to read notification store"), scanErr)
+		}
+	} else if !errors.Is(openErr, os.ErrNotExist) {
+		return nil, errors.Join(errors.New("unable to open notification store"), openErr)
+	}
+	if err := s.compact(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (s *FileStore) Add(ctx context.Context, notificationId string) (bool, error) {
+	s.memory.mutex.Lock()
+	defer s.memory.mutex.Unlock()
+	if !s.memory.add(notificationId) {
+		return false, nil
+	}
+	if err := s.append(notificationId); err != nil {
+		s.memory.remove(notificationId)
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *FileStore) Remove(ctx context.Context, notificationId string) error {
+	s.memory.mutex.Lock()
+	defer s.memory.mutex.Unlock()
+	if _, ok := s.memory.ids[notificationId]; !ok {
+		return nil
+	}
+	s.memory.remove(notificationId)
+	return s.append("-" + notificationId)
+}
+
+// Close closes the file.
+func (s *FileStore) Close() error {
+	s.memory.mutex.Lock()
+	defer s.memory.mutex.Unlock()
+	return s.file.Close()
+}
+
+// append writes a line and syncs it to disk, compacting the file when it has grown to twice the capacity. The line is
+// recorded once synced, so a failed compaction is not reported and is retried by the next append.
+func (s *FileStore) append(line string) error {
+	if _, err := s.file.WriteString(line + "\n"); err != nil {
+		return errors.Join(errors.New("unable to write notification store"), err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return errors.Join(errors.New("unable to write notification store"), err)
+	}
+	s.lines++
+	if s.lines >= 2*s.memory.capacity {
+		s.compact()
+	}
+	return nil
+}
+
+// compact replaces the file with the ids currently held, oldest first, and keeps it open for appending. The current file
+// is kept when the replacement cannot be written.
+func (s *FileStore) compact() error {
+	temp, createErr := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if createErr != nil {
+		return errors.Join(errors.New("unable to compact notification store"), createErr)
+	}
+	writer := bufio.NewWriter(temp)
+	for element := s.memory.order.Front(); element != nil; element = element.Next() {
+		writer.WriteString(element.Value.(string) + "\n")
+	}
+	writeErr := writer.Flush()
+	if writeErr == nil {
+		writeErr = temp.Sync()
+	}
+	if writeErr == nil {
+		writeErr = os.Rename(temp.Name(), s.path)
+	}
+	if writeErr != nil {
+		temp.Close()
+		os.Remove(temp.Name())
+		return errors.Join(errors.New("unable to compact notification store"), writeErr)
+	}
+	if s.file != nil {
+		s.file.Close()
+	}
+	s.file = temp
+	s.lines = s.memory.order.Len()
+	return nil
+}
@@ -0,0 +1,238 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	authnet "github.com/BigBallard/gogo-authnet"
+)
+
+func TestMemoryStoreEvictsOldest(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore(2)
+	for _, id := range []string{"a", "b", "c"} {
+		if added, _ := store.Add(ctx, id); !added {
+			t.Fatalf("expected %s to be added", id)
+		}
+	}
+	if added, _ := store.Add(ctx, "c"); added {
+		t.Fatal("expected c to be a duplicate")
+	}
+	if added, _ := store.Add(ctx, "a"); !added {
+		t.Fatal("expected a to have been evicted")
+	}
+}
+
+func TestFileStorePersists(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "notifications")
+	store, openErr := OpenFileStore(path, 3)
+	if openErr != nil {
+		t.Fatal(openErr)
+	}
+	for _, id := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+		if _, err := store.Add(ctx, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.Remove(ctx, "g"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, reopenErr := OpenFileStore(path, 3)
+	if reopenErr != nil {
+		t.Fatal(reopenErr)
+	}
+	defer reopened.Close()
+	for _, c := range []struct {
+		id    string
+		added bool
+	}{{"e", false}, {"f", false}, {"g", true}} {
+		if added, err := reopened.Add(ctx, c.id); err != nil || added != c.added {
+			t.Errorf("%s: expected added %t, got %t %v", c.id, c.added, added, err)
+		}
+	}
+}
+
+func TestFileStoreCompactionFailure(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "notifications")
+	store, openErr := OpenFileStore(path, 2)
+	if openErr != nil {
+		t.Fatal(openErr)
+	}
+	defer store.Close()
+
+	// Move the open file aside and put a non empty directory in its place, so that the compacted file cannot replace it.
+	moved := path + ".moved"
+	if err := os.Rename(path, moved); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(path, "blocked"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"a", "b", "c", "d"} {
+		if added, err := store.Add(ctx, id); err != nil || !added {
+			t.Fatalf("%s: expected the id to be added despite the failed compaction, got %t %v", id, added, err)
+		}
+	}
+	if added, err := store.Add(ctx, "d"); err != nil || added {
+		t.Fatalf("expected d to be a duplicate, got %t %v", added, err)
+	}
+
+	if err := os.RemoveAll(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(moved, path); err != nil {
+		t.Fatal(err)
+	}
+	if added, err := store.Add(ctx, "e"); err != nil || !added {
+		t.Fatalf("expected e to be added, got %t %v", added, err)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Errorf("expected only the store file to remain, got %v", entries)
+	}
+	contents, readErr := os.ReadFile(path)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	if string(contents) != "d\ne\n" {
+		t.Errorf("expected the retried compaction to keep d and e, got %q", contents)
+	}
+	if added, err := store.Add(ctx, "f"); err != nil || !added {
+		t.Fatalf("expected f to be added after the compaction, got %t %v", added, err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, reopenErr := OpenFileStore(path, 2)
+	if reopenErr != nil {
+		t.Fatal(reopenErr)
+	}
+	defer reopened.Close()
+	if added, err := reopened.Add(ctx, "f"); err != nil || added {
+		t.Errorf("expected f to have been persisted to the compacted file, got %t %v", added, err)
+	}
+}
+
+func TestHandlerSkipsDuplicates(t *testing.T) {
+	calls := 0
+	fail := true
+	h := NewHandler(testSignatureKey,
+		WithClock(func() time.Time { return testNow }),
+		WithStore(NewMemoryStore(0)),
+		OnSubscription(func(ctx context.Context, event *SubscriptionEvent) error {
+			calls++
+			if fail {
+				return errors.New("unavailable")
+			}
+			return nil
+		}),
+	)
+	body := `{"notificationId":"n1","eventType":"net.authorize.customer.subscription.created","eventDate":"2023-03-29T20:48:02Z",` +
+		`"webhookId":"w1","payload":{"entityName":"subscription","id":"100188","amount":23.45}}`
+	signature := Sign(testSignatureKey, []byte(body))
+	for i, status := range []int{http.StatusInternalServerError, http.StatusOK, http.StatusOK} {
+		if res := deliver(h, body, signature); res.Code != status {
+			t.Fatalf("delivery %d: expected %d, got %d", i, status, res.Code)
+		}
+		fail = false
+	}
+	if calls != 2 {
+		t.Fatalf("expected the failed and the first successful delivery to be processed, got %d calls", calls)
+	}
+}
+
+func TestReplayerProcessesHistoryOldestFirst(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/rest/v1/notifications":
+			w.Write([]byte(`{"notifications":[` +
+				`{"notificationId":"n3","deliveryStatus":"Failed","eventType":"net.authorize.customer.subscription.cancelled","eventDate":"2023-03-29T12:00:00.123","webhookId":"w1"},` +
+				`{"notificationId":"n2","deliveryStatus":"Delivered","eventType":"net.authorize.customer.subscription.created","eventDate":"2023-03-29T11:00:00.123","webhookId":"w1"},` +
+				`{"notificationId":"n1","deliveryStatus":"Failed","eventType":"net.authorize.customer.subscription.created","eventDate":"2023-03-28T11:00:00.123","webhookId":"w1"}]}`))
+		case strings.HasPrefix(r.URL.Path, "/rest/v1/notifications/"):
+			id := strings.TrimPrefix(r.URL.Path, "/rest/v1/notifications/")
+			fmt.Fprintf(w, `{"notificationId":%q,"deliveryStatus":"Failed","eventType":"net.authorize.customer.subscription.created",`+
+				`"eventDate":"2023-03-29T11:00:00.123","webhookId":"w1","payload":{"entityName":"subscription","id":%q}}`, id, id)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	var processed []ID
+	store := NewMemoryStore(0)
+	store.Add(context.Background(), "n2")
+	h := NewHandler(testSignatureKey, WithStore(store), OnSubscription(func(ctx context.Context, event *SubscriptionEvent) error {
+		processed = append(processed, event.Payload.Id)
+		return nil
+	}))
+	replayer := NewReplayer(NewClient(authnet.Config{AuthnetHost: srv.URL}), h)
+	count, err := replayer.Replay(context.Background(), time.Date(2023, 3, 29, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 || len(processed) != 1 || processed[0] != "n3" {
+		t.Fatalf("expected n3 to be processed once, got %d %v", count, processed)
+	}
+}
+
+func TestReplayerReadsEveryPage(t *testing.T) {
+	var pages []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/rest/v1/notifications":
+			offset := r.URL.Query().Get("offset")
+			pages = append(pages, offset)
+			var summaries []string
+			switch offset {
+			case "0":
+				// A full page of notifications older than since, followed by a page with a newer one.
+				for i := 0; i < MaxNotificationPageSize; i++ {
+					summaries = append(summaries, fmt.Sprintf(`{"notificationId":"old%d","deliveryStatus":"Delivered",`+
+						`"eventType":"net.authorize.customer.created","eventDate":"2023-03-01T00:00:00","webhookId":"w1"}`, i))
+				}
+			case "1000":
+				summaries = append(summaries, `{"notificationId":"new","deliveryStatus":"Failed",`+
+					`"eventType":"net.authorize.customer.created","eventDate":"2023-03-29T00:00:00","webhookId":"w1"}`)
+			}
+			fmt.Fprintf(w, `{"notifications":[%s]}`, strings.Join(summaries, ","))
+		case r.URL.Path == "/rest/v1/notifications/new":
+			w.Write([]byte(`{"notificationId":"new","deliveryStatus":"Failed","eventType":"net.authorize.customer.created",` +
+				`"eventDate":"2023-03-29T00:00:00","webhookId":"w1","payload":{"entityName":"customerProfile","id":"1"}}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	var processed []ID
+	h := NewHandler(testSignatureKey, OnCustomer(func(ctx context.Context, event *CustomerEvent) error {
+		processed = append(processed, event.Payload.Id)
+		return nil
+	}))
+	count, err := NewReplayer(NewClient(authnet.Config{AuthnetHost: srv.URL}), h).
+		Replay(context.Background(), time.Date(2023, 3, 28, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(pages, ",") != "0,1000" || count != 1 || len(processed) != 1 || processed[0] != "1" {
+		t.Fatalf("expected both pages to be read and the newer notification processed, got pages %v, %d %v", pages, count, processed)
+	}
+}